@@ -19,9 +19,25 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Queue_SendMessage_FullMethodName    = "/queue.Queue/SendMessage"
-	Queue_ReceiveMessage_FullMethodName = "/queue.Queue/ReceiveMessage"
-	Queue_DeleteMessage_FullMethodName  = "/queue.Queue/DeleteMessage"
+	Queue_SendMessage_FullMethodName                  = "/queue.Queue/SendMessage"
+	Queue_SendMessageBatch_FullMethodName             = "/queue.Queue/SendMessageBatch"
+	Queue_ReceiveMessage_FullMethodName               = "/queue.Queue/ReceiveMessage"
+	Queue_StreamingReceive_FullMethodName             = "/queue.Queue/StreamingReceive"
+	Queue_DeleteMessage_FullMethodName                = "/queue.Queue/DeleteMessage"
+	Queue_DeleteMessageBatch_FullMethodName           = "/queue.Queue/DeleteMessageBatch"
+	Queue_ChangeMessageVisibility_FullMethodName      = "/queue.Queue/ChangeMessageVisibility"
+	Queue_ChangeMessageVisibilityBatch_FullMethodName = "/queue.Queue/ChangeMessageVisibilityBatch"
+	Queue_CreateQueue_FullMethodName                  = "/queue.Queue/CreateQueue"
+	Queue_DeleteQueue_FullMethodName                  = "/queue.Queue/DeleteQueue"
+	Queue_ListQueues_FullMethodName                   = "/queue.Queue/ListQueues"
+	Queue_GetQueueAttributes_FullMethodName           = "/queue.Queue/GetQueueAttributes"
+	Queue_SetQueueAttributes_FullMethodName           = "/queue.Queue/SetQueueAttributes"
+	Queue_StartMessageMoveTask_FullMethodName         = "/queue.Queue/StartMessageMoveTask"
+	Queue_ListMessageMoveTasks_FullMethodName         = "/queue.Queue/ListMessageMoveTasks"
+	Queue_CancelMessageMoveTask_FullMethodName        = "/queue.Queue/CancelMessageMoveTask"
+	Queue_ScheduleMessage_FullMethodName              = "/queue.Queue/ScheduleMessage"
+	Queue_ListSchedules_FullMethodName                = "/queue.Queue/ListSchedules"
+	Queue_CancelSchedule_FullMethodName               = "/queue.Queue/CancelSchedule"
 )
 
 // QueueClient is the client API for Queue service.
@@ -32,10 +48,45 @@ const (
 type QueueClient interface {
 	// Sends a message to the queue
 	SendMessage(ctx context.Context, in *SendMessageRequest, opts ...grpc.CallOption) (*SendMessageResponse, error)
-	// Receives a message from the queue with visibility timeout
+	// Sends up to 10 messages to the queue
+	SendMessageBatch(ctx context.Context, in *SendMessageBatchRequest, opts ...grpc.CallOption) (*SendMessageBatchResponse, error)
+	// Receives a message from the queue with visibility timeout. The response
+	// is empty when no message arrived before the wait ran out.
 	ReceiveMessage(ctx context.Context, in *ReceiveMessageRequest, opts ...grpc.CallOption) (*ReceiveMessageResponse, error)
+	// Opens a consumer session on a queue. The server pushes messages as long
+	// as the client grants credits, and the client acks, nacks or extends
+	// them on the same stream.
+	StreamingReceive(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[StreamingReceiveRequest, StreamingReceiveResponse], error)
 	// Deletes a message from the queue using its receipt handle
 	DeleteMessage(ctx context.Context, in *DeleteMessageRequest, opts ...grpc.CallOption) (*DeleteMessageResponse, error)
+	// Deletes up to 10 messages using their receipt handles
+	DeleteMessageBatch(ctx context.Context, in *DeleteMessageBatchRequest, opts ...grpc.CallOption) (*DeleteMessageBatchResponse, error)
+	// Changes the visibility timeout of a received message using its receipt handle
+	ChangeMessageVisibility(ctx context.Context, in *ChangeMessageVisibilityRequest, opts ...grpc.CallOption) (*ChangeMessageVisibilityResponse, error)
+	// Changes the visibility timeout of up to 10 received messages
+	ChangeMessageVisibilityBatch(ctx context.Context, in *ChangeMessageVisibilityBatchRequest, opts ...grpc.CallOption) (*ChangeMessageVisibilityBatchResponse, error)
+	// Creates a new queue
+	CreateQueue(ctx context.Context, in *CreateQueueRequest, opts ...grpc.CallOption) (*CreateQueueResponse, error)
+	// Deletes a queue and every message it holds
+	DeleteQueue(ctx context.Context, in *DeleteQueueRequest, opts ...grpc.CallOption) (*DeleteQueueResponse, error)
+	// Lists the queues, optionally filtered by a name prefix
+	ListQueues(ctx context.Context, in *ListQueuesRequest, opts ...grpc.CallOption) (*ListQueuesResponse, error)
+	// Returns the attributes of a queue
+	GetQueueAttributes(ctx context.Context, in *GetQueueAttributesRequest, opts ...grpc.CallOption) (*GetQueueAttributesResponse, error)
+	// Updates the configurable attributes of a queue
+	SetQueueAttributes(ctx context.Context, in *SetQueueAttributesRequest, opts ...grpc.CallOption) (*SetQueueAttributesResponse, error)
+	// Starts moving the messages of a dead-letter queue back to their source queues
+	StartMessageMoveTask(ctx context.Context, in *StartMessageMoveTaskRequest, opts ...grpc.CallOption) (*StartMessageMoveTaskResponse, error)
+	// Lists the message move tasks of a source queue
+	ListMessageMoveTasks(ctx context.Context, in *ListMessageMoveTasksRequest, opts ...grpc.CallOption) (*ListMessageMoveTasksResponse, error)
+	// Cancels a running message move task
+	CancelMessageMoveTask(ctx context.Context, in *CancelMessageMoveTaskRequest, opts ...grpc.CallOption) (*CancelMessageMoveTaskResponse, error)
+	// Schedules a message for a future time, or on a recurring cron expression
+	ScheduleMessage(ctx context.Context, in *ScheduleMessageRequest, opts ...grpc.CallOption) (*ScheduleMessageResponse, error)
+	// Lists the schedules of a queue
+	ListSchedules(ctx context.Context, in *ListSchedulesRequest, opts ...grpc.CallOption) (*ListSchedulesResponse, error)
+	// Cancels a schedule
+	CancelSchedule(ctx context.Context, in *CancelScheduleRequest, opts ...grpc.CallOption) (*CancelScheduleResponse, error)
 }
 
 type queueClient struct {
@@ -56,6 +107,16 @@ func (c *queueClient) SendMessage(ctx context.Context, in *SendMessageRequest, o
 	return out, nil
 }
 
+func (c *queueClient) SendMessageBatch(ctx context.Context, in *SendMessageBatchRequest, opts ...grpc.CallOption) (*SendMessageBatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendMessageBatchResponse)
+	err := c.cc.Invoke(ctx, Queue_SendMessageBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queueClient) ReceiveMessage(ctx context.Context, in *ReceiveMessageRequest, opts ...grpc.CallOption) (*ReceiveMessageResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ReceiveMessageResponse)
@@ -66,6 +127,19 @@ func (c *queueClient) ReceiveMessage(ctx context.Context, in *ReceiveMessageRequ
 	return out, nil
 }
 
+func (c *queueClient) StreamingReceive(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[StreamingReceiveRequest, StreamingReceiveResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Queue_ServiceDesc.Streams[0], Queue_StreamingReceive_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamingReceiveRequest, StreamingReceiveResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Queue_StreamingReceiveClient = grpc.BidiStreamingClient[StreamingReceiveRequest, StreamingReceiveResponse]
+
 func (c *queueClient) DeleteMessage(ctx context.Context, in *DeleteMessageRequest, opts ...grpc.CallOption) (*DeleteMessageResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteMessageResponse)
@@ -76,6 +150,146 @@ func (c *queueClient) DeleteMessage(ctx context.Context, in *DeleteMessageReques
 	return out, nil
 }
 
+func (c *queueClient) DeleteMessageBatch(ctx context.Context, in *DeleteMessageBatchRequest, opts ...grpc.CallOption) (*DeleteMessageBatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteMessageBatchResponse)
+	err := c.cc.Invoke(ctx, Queue_DeleteMessageBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) ChangeMessageVisibility(ctx context.Context, in *ChangeMessageVisibilityRequest, opts ...grpc.CallOption) (*ChangeMessageVisibilityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeMessageVisibilityResponse)
+	err := c.cc.Invoke(ctx, Queue_ChangeMessageVisibility_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) ChangeMessageVisibilityBatch(ctx context.Context, in *ChangeMessageVisibilityBatchRequest, opts ...grpc.CallOption) (*ChangeMessageVisibilityBatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeMessageVisibilityBatchResponse)
+	err := c.cc.Invoke(ctx, Queue_ChangeMessageVisibilityBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) CreateQueue(ctx context.Context, in *CreateQueueRequest, opts ...grpc.CallOption) (*CreateQueueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateQueueResponse)
+	err := c.cc.Invoke(ctx, Queue_CreateQueue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) DeleteQueue(ctx context.Context, in *DeleteQueueRequest, opts ...grpc.CallOption) (*DeleteQueueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteQueueResponse)
+	err := c.cc.Invoke(ctx, Queue_DeleteQueue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) ListQueues(ctx context.Context, in *ListQueuesRequest, opts ...grpc.CallOption) (*ListQueuesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListQueuesResponse)
+	err := c.cc.Invoke(ctx, Queue_ListQueues_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) GetQueueAttributes(ctx context.Context, in *GetQueueAttributesRequest, opts ...grpc.CallOption) (*GetQueueAttributesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetQueueAttributesResponse)
+	err := c.cc.Invoke(ctx, Queue_GetQueueAttributes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) SetQueueAttributes(ctx context.Context, in *SetQueueAttributesRequest, opts ...grpc.CallOption) (*SetQueueAttributesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetQueueAttributesResponse)
+	err := c.cc.Invoke(ctx, Queue_SetQueueAttributes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) StartMessageMoveTask(ctx context.Context, in *StartMessageMoveTaskRequest, opts ...grpc.CallOption) (*StartMessageMoveTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartMessageMoveTaskResponse)
+	err := c.cc.Invoke(ctx, Queue_StartMessageMoveTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) ListMessageMoveTasks(ctx context.Context, in *ListMessageMoveTasksRequest, opts ...grpc.CallOption) (*ListMessageMoveTasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMessageMoveTasksResponse)
+	err := c.cc.Invoke(ctx, Queue_ListMessageMoveTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) CancelMessageMoveTask(ctx context.Context, in *CancelMessageMoveTaskRequest, opts ...grpc.CallOption) (*CancelMessageMoveTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelMessageMoveTaskResponse)
+	err := c.cc.Invoke(ctx, Queue_CancelMessageMoveTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) ScheduleMessage(ctx context.Context, in *ScheduleMessageRequest, opts ...grpc.CallOption) (*ScheduleMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduleMessageResponse)
+	err := c.cc.Invoke(ctx, Queue_ScheduleMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) ListSchedules(ctx context.Context, in *ListSchedulesRequest, opts ...grpc.CallOption) (*ListSchedulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSchedulesResponse)
+	err := c.cc.Invoke(ctx, Queue_ListSchedules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queueClient) CancelSchedule(ctx context.Context, in *CancelScheduleRequest, opts ...grpc.CallOption) (*CancelScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelScheduleResponse)
+	err := c.cc.Invoke(ctx, Queue_CancelSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueueServer is the server API for Queue service.
 // All implementations must embed UnimplementedQueueServer
 // for forward compatibility.
@@ -84,10 +298,45 @@ func (c *queueClient) DeleteMessage(ctx context.Context, in *DeleteMessageReques
 type QueueServer interface {
 	// Sends a message to the queue
 	SendMessage(context.Context, *SendMessageRequest) (*SendMessageResponse, error)
-	// Receives a message from the queue with visibility timeout
+	// Sends up to 10 messages to the queue
+	SendMessageBatch(context.Context, *SendMessageBatchRequest) (*SendMessageBatchResponse, error)
+	// Receives a message from the queue with visibility timeout. The response
+	// is empty when no message arrived before the wait ran out.
 	ReceiveMessage(context.Context, *ReceiveMessageRequest) (*ReceiveMessageResponse, error)
+	// Opens a consumer session on a queue. The server pushes messages as long
+	// as the client grants credits, and the client acks, nacks or extends
+	// them on the same stream.
+	StreamingReceive(grpc.BidiStreamingServer[StreamingReceiveRequest, StreamingReceiveResponse]) error
 	// Deletes a message from the queue using its receipt handle
 	DeleteMessage(context.Context, *DeleteMessageRequest) (*DeleteMessageResponse, error)
+	// Deletes up to 10 messages using their receipt handles
+	DeleteMessageBatch(context.Context, *DeleteMessageBatchRequest) (*DeleteMessageBatchResponse, error)
+	// Changes the visibility timeout of a received message using its receipt handle
+	ChangeMessageVisibility(context.Context, *ChangeMessageVisibilityRequest) (*ChangeMessageVisibilityResponse, error)
+	// Changes the visibility timeout of up to 10 received messages
+	ChangeMessageVisibilityBatch(context.Context, *ChangeMessageVisibilityBatchRequest) (*ChangeMessageVisibilityBatchResponse, error)
+	// Creates a new queue
+	CreateQueue(context.Context, *CreateQueueRequest) (*CreateQueueResponse, error)
+	// Deletes a queue and every message it holds
+	DeleteQueue(context.Context, *DeleteQueueRequest) (*DeleteQueueResponse, error)
+	// Lists the queues, optionally filtered by a name prefix
+	ListQueues(context.Context, *ListQueuesRequest) (*ListQueuesResponse, error)
+	// Returns the attributes of a queue
+	GetQueueAttributes(context.Context, *GetQueueAttributesRequest) (*GetQueueAttributesResponse, error)
+	// Updates the configurable attributes of a queue
+	SetQueueAttributes(context.Context, *SetQueueAttributesRequest) (*SetQueueAttributesResponse, error)
+	// Starts moving the messages of a dead-letter queue back to their source queues
+	StartMessageMoveTask(context.Context, *StartMessageMoveTaskRequest) (*StartMessageMoveTaskResponse, error)
+	// Lists the message move tasks of a source queue
+	ListMessageMoveTasks(context.Context, *ListMessageMoveTasksRequest) (*ListMessageMoveTasksResponse, error)
+	// Cancels a running message move task
+	CancelMessageMoveTask(context.Context, *CancelMessageMoveTaskRequest) (*CancelMessageMoveTaskResponse, error)
+	// Schedules a message for a future time, or on a recurring cron expression
+	ScheduleMessage(context.Context, *ScheduleMessageRequest) (*ScheduleMessageResponse, error)
+	// Lists the schedules of a queue
+	ListSchedules(context.Context, *ListSchedulesRequest) (*ListSchedulesResponse, error)
+	// Cancels a schedule
+	CancelSchedule(context.Context, *CancelScheduleRequest) (*CancelScheduleResponse, error)
 	mustEmbedUnimplementedQueueServer()
 }
 
@@ -101,12 +350,60 @@ type UnimplementedQueueServer struct{}
 func (UnimplementedQueueServer) SendMessage(context.Context, *SendMessageRequest) (*SendMessageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendMessage not implemented")
 }
+func (UnimplementedQueueServer) SendMessageBatch(context.Context, *SendMessageBatchRequest) (*SendMessageBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendMessageBatch not implemented")
+}
 func (UnimplementedQueueServer) ReceiveMessage(context.Context, *ReceiveMessageRequest) (*ReceiveMessageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReceiveMessage not implemented")
 }
+func (UnimplementedQueueServer) StreamingReceive(grpc.BidiStreamingServer[StreamingReceiveRequest, StreamingReceiveResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamingReceive not implemented")
+}
 func (UnimplementedQueueServer) DeleteMessage(context.Context, *DeleteMessageRequest) (*DeleteMessageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteMessage not implemented")
 }
+func (UnimplementedQueueServer) DeleteMessageBatch(context.Context, *DeleteMessageBatchRequest) (*DeleteMessageBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMessageBatch not implemented")
+}
+func (UnimplementedQueueServer) ChangeMessageVisibility(context.Context, *ChangeMessageVisibilityRequest) (*ChangeMessageVisibilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeMessageVisibility not implemented")
+}
+func (UnimplementedQueueServer) ChangeMessageVisibilityBatch(context.Context, *ChangeMessageVisibilityBatchRequest) (*ChangeMessageVisibilityBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeMessageVisibilityBatch not implemented")
+}
+func (UnimplementedQueueServer) CreateQueue(context.Context, *CreateQueueRequest) (*CreateQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateQueue not implemented")
+}
+func (UnimplementedQueueServer) DeleteQueue(context.Context, *DeleteQueueRequest) (*DeleteQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteQueue not implemented")
+}
+func (UnimplementedQueueServer) ListQueues(context.Context, *ListQueuesRequest) (*ListQueuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQueues not implemented")
+}
+func (UnimplementedQueueServer) GetQueueAttributes(context.Context, *GetQueueAttributesRequest) (*GetQueueAttributesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQueueAttributes not implemented")
+}
+func (UnimplementedQueueServer) SetQueueAttributes(context.Context, *SetQueueAttributesRequest) (*SetQueueAttributesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetQueueAttributes not implemented")
+}
+func (UnimplementedQueueServer) StartMessageMoveTask(context.Context, *StartMessageMoveTaskRequest) (*StartMessageMoveTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartMessageMoveTask not implemented")
+}
+func (UnimplementedQueueServer) ListMessageMoveTasks(context.Context, *ListMessageMoveTasksRequest) (*ListMessageMoveTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMessageMoveTasks not implemented")
+}
+func (UnimplementedQueueServer) CancelMessageMoveTask(context.Context, *CancelMessageMoveTaskRequest) (*CancelMessageMoveTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelMessageMoveTask not implemented")
+}
+func (UnimplementedQueueServer) ScheduleMessage(context.Context, *ScheduleMessageRequest) (*ScheduleMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleMessage not implemented")
+}
+func (UnimplementedQueueServer) ListSchedules(context.Context, *ListSchedulesRequest) (*ListSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSchedules not implemented")
+}
+func (UnimplementedQueueServer) CancelSchedule(context.Context, *CancelScheduleRequest) (*CancelScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSchedule not implemented")
+}
 func (UnimplementedQueueServer) mustEmbedUnimplementedQueueServer() {}
 func (UnimplementedQueueServer) testEmbeddedByValue()               {}
 
@@ -146,6 +443,24 @@ func _Queue_SendMessage_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Queue_SendMessageBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendMessageBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).SendMessageBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Queue_SendMessageBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).SendMessageBatch(ctx, req.(*SendMessageBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Queue_ReceiveMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ReceiveMessageRequest)
 	if err := dec(in); err != nil {
@@ -164,6 +479,13 @@ func _Queue_ReceiveMessage_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Queue_StreamingReceive_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(QueueServer).StreamingReceive(&grpc.GenericServerStream[StreamingReceiveRequest, StreamingReceiveResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Queue_StreamingReceiveServer = grpc.BidiStreamingServer[StreamingReceiveRequest, StreamingReceiveResponse]
+
 func _Queue_DeleteMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteMessageRequest)
 	if err := dec(in); err != nil {
@@ -182,6 +504,258 @@ func _Queue_DeleteMessage_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Queue_DeleteMessageBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMessageBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).DeleteMessageBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Queue_DeleteMessageBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).DeleteMessageBatch(ctx, req.(*DeleteMessageBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_ChangeMessageVisibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeMessageVisibilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).ChangeMessageVisibility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Queue_ChangeMessageVisibility_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).ChangeMessageVisibility(ctx, req.(*ChangeMessageVisibilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_ChangeMessageVisibilityBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeMessageVisibilityBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).ChangeMessageVisibilityBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Queue_ChangeMessageVisibilityBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).ChangeMessageVisibilityBatch(ctx, req.(*ChangeMessageVisibilityBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_CreateQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).CreateQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Queue_CreateQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).CreateQueue(ctx, req.(*CreateQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_DeleteQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).DeleteQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Queue_DeleteQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).DeleteQueue(ctx, req.(*DeleteQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_ListQueues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQueuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).ListQueues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Queue_ListQueues_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).ListQueues(ctx, req.(*ListQueuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_GetQueueAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQueueAttributesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).GetQueueAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Queue_GetQueueAttributes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).GetQueueAttributes(ctx, req.(*GetQueueAttributesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_SetQueueAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetQueueAttributesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).SetQueueAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Queue_SetQueueAttributes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).SetQueueAttributes(ctx, req.(*SetQueueAttributesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_StartMessageMoveTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartMessageMoveTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).StartMessageMoveTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Queue_StartMessageMoveTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).StartMessageMoveTask(ctx, req.(*StartMessageMoveTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_ListMessageMoveTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMessageMoveTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).ListMessageMoveTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Queue_ListMessageMoveTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).ListMessageMoveTasks(ctx, req.(*ListMessageMoveTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_CancelMessageMoveTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelMessageMoveTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).CancelMessageMoveTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Queue_CancelMessageMoveTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).CancelMessageMoveTask(ctx, req.(*CancelMessageMoveTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_ScheduleMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).ScheduleMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Queue_ScheduleMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).ScheduleMessage(ctx, req.(*ScheduleMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_ListSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).ListSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Queue_ListSchedules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).ListSchedules(ctx, req.(*ListSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Queue_CancelSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueueServer).CancelSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Queue_CancelSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueueServer).CancelSchedule(ctx, req.(*CancelScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Queue_ServiceDesc is the grpc.ServiceDesc for Queue service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -193,6 +767,10 @@ var Queue_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendMessage",
 			Handler:    _Queue_SendMessage_Handler,
 		},
+		{
+			MethodName: "SendMessageBatch",
+			Handler:    _Queue_SendMessageBatch_Handler,
+		},
 		{
 			MethodName: "ReceiveMessage",
 			Handler:    _Queue_ReceiveMessage_Handler,
@@ -201,7 +779,70 @@ var Queue_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteMessage",
 			Handler:    _Queue_DeleteMessage_Handler,
 		},
+		{
+			MethodName: "DeleteMessageBatch",
+			Handler:    _Queue_DeleteMessageBatch_Handler,
+		},
+		{
+			MethodName: "ChangeMessageVisibility",
+			Handler:    _Queue_ChangeMessageVisibility_Handler,
+		},
+		{
+			MethodName: "ChangeMessageVisibilityBatch",
+			Handler:    _Queue_ChangeMessageVisibilityBatch_Handler,
+		},
+		{
+			MethodName: "CreateQueue",
+			Handler:    _Queue_CreateQueue_Handler,
+		},
+		{
+			MethodName: "DeleteQueue",
+			Handler:    _Queue_DeleteQueue_Handler,
+		},
+		{
+			MethodName: "ListQueues",
+			Handler:    _Queue_ListQueues_Handler,
+		},
+		{
+			MethodName: "GetQueueAttributes",
+			Handler:    _Queue_GetQueueAttributes_Handler,
+		},
+		{
+			MethodName: "SetQueueAttributes",
+			Handler:    _Queue_SetQueueAttributes_Handler,
+		},
+		{
+			MethodName: "StartMessageMoveTask",
+			Handler:    _Queue_StartMessageMoveTask_Handler,
+		},
+		{
+			MethodName: "ListMessageMoveTasks",
+			Handler:    _Queue_ListMessageMoveTasks_Handler,
+		},
+		{
+			MethodName: "CancelMessageMoveTask",
+			Handler:    _Queue_CancelMessageMoveTask_Handler,
+		},
+		{
+			MethodName: "ScheduleMessage",
+			Handler:    _Queue_ScheduleMessage_Handler,
+		},
+		{
+			MethodName: "ListSchedules",
+			Handler:    _Queue_ListSchedules_Handler,
+		},
+		{
+			MethodName: "CancelSchedule",
+			Handler:    _Queue_CancelSchedule_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamingReceive",
+			Handler:       _Queue_StreamingReceive_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "queue.proto",
 }
@@ -8,11 +8,16 @@ import (
 	pb "queueserver/api"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/status"
 )
 
 func main() {
-	conn, err := grpc.NewClient("server:50051", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	// Requests, and the responses to them, are compressed with gzip
+	conn, err := grpc.NewClient("server:50051", grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.UseCompressor(gzip.Name)))
 	if err != nil {
 		log.Fatalf("Failed to connect: %v", err)
 	}
@@ -23,7 +28,12 @@ func main() {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 	defer cancel()
 
-	sendResp, err := c.SendMessage(ctx, &pb.SendMessageRequest{MessageBody: "Hello, SQS!", QueueName: "queue1"})
+	_, err = c.CreateQueue(ctx, &pb.CreateQueueRequest{QueueName: "queue1"})
+	if err != nil && status.Code(err) != codes.AlreadyExists {
+		log.Fatalf("Could not create queue: %v", err)
+	}
+
+	sendResp, err := c.SendMessage(ctx, &pb.SendMessageRequest{MessageBody: []byte("Hello, SQS!"), ContentType: "text/plain", QueueName: "queue1"})
 	if err != nil {
 		log.Fatalf("Could not send message: %v", err)
 	}
@@ -35,7 +45,7 @@ func main() {
 	}
 	log.Printf("Message received: %s", receiveResp.MessageBody)
 
-	deleteResp, err := c.DeleteMessage(ctx, &pb.DeleteMessageRequest{ReceiptHandle: receiveResp.ReceiptHandle})
+	deleteResp, err := c.DeleteMessage(ctx, &pb.DeleteMessageRequest{ReceiptHandle: receiveResp.ReceiptHandle, QueueName: "queue1"})
 	if err != nil {
 		log.Fatalf("Could not delete message: %v", err)
 	}
@@ -1,13 +1,19 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
 
 	"queueserver/internal/adapter/config"
 	"queueserver/internal/adapter/repository"
 	grpcCtrl "queueserver/internal/controller/grpc"
 	grpcConfig "queueserver/internal/core/config"
+	"queueserver/internal/core/domain"
 	"queueserver/internal/core/server"
 	"queueserver/internal/core/server/grpc"
 	"queueserver/internal/core/service"
@@ -16,10 +22,14 @@ import (
 
 	"github.com/joho/godotenv"
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	googleGrpc "google.golang.org/grpc"
 	"google.golang.org/grpc/keepalive"
 )
 
+// metricsAddr is where Prometheus scrapes the metrics, see prometheus.yml
+const metricsAddr = ":2112"
+
 var (
 	messagesProduced = prometheus.NewCounter(prometheus.CounterOpts{
 		Name: "messages_produced_total",
@@ -35,39 +45,63 @@ var (
 		Name: "messages_deleted_total",
 		Help: "Total number of messages consumed from the queue.",
 	})
+
+	messagesExpired = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "messages_expired_total",
+		Help: "Total number of messages deleted after the retention period of their queue.",
+	}, []string{"queue"})
 )
 
 func init() {
 	prometheus.MustRegister(messagesProduced)
 	prometheus.MustRegister(messagesConsumed)
 	prometheus.MustRegister(messagesDeleted)
+	prometheus.MustRegister(messagesExpired)
 }
 
 // QueueServer is the gRPC server that implements the Queue service
 func main() {
-	// Carrega o arquivo .env
-	err := godotenv.Load()
-	if err != nil {
-		log.Fatalf("Erro ao carregar o arquivo .env: %v", err)
+	// Carrega o arquivo .env, quando existir
+	if err := godotenv.Load(); err != nil {
+		log.Printf("no .env file loaded: %v", err)
 	}
 
 	// Create a new Config
 	config := config.NewConfig()
 
-	// Create a Message Repository
-	messageRepo, err := repository.NewPostgresMessageRepository(config)
-	if err != nil {
-		panic(fmt.Sprintf("error to create a Message Repository: %v", err))
+	// Run the migrate subcommand instead of the server when requested
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := runMigrate(config, os.Args[2:]); err != nil {
+			log.Fatalf("migrate: %v", err)
+		}
+		return
+	}
+
+	// Apply pending schema migrations
+	if config.AutoMigrate {
+		if err := runMigrate(config, []string{"up"}); err != nil {
+			log.Fatalf("failed to migrate the database: %v", err)
+		}
 	}
 
-	// Create a Queue Repository
-	queueRepo, err := repository.NewPostgresQueueRepository(config)
+	// Create the Queue, Message and Schedule Repositories of the configured backend
+	repos, err := repository.NewRepositories(config)
 	if err != nil {
-		panic(fmt.Sprintf("error to create a Queue Repository: %v", err))
+		panic(err.Error())
+	}
+
+	// Offload large message bodies when a blob store is configured
+	options := []service.Option{service.WithLifecycleListener(lifecycleListener(config))}
+	if config.BlobStorePath != "" {
+		blobStore, err := repository.NewFilesystemBlobStore(config)
+		if err != nil {
+			panic(err.Error())
+		}
+		options = append(options, service.WithBlobStore(blobStore, config.BlobThreshold))
 	}
 
 	// Create a new Service
-	queueService := service.NewQueueService(queueRepo, messageRepo)
+	queueService := service.NewQueueService(repos.Queues, repos.Messages, repos.Schedules, options...)
 
 	// Create a new Controller
 	userController := grpcCtrl.NewQueueController(queueService)
@@ -87,6 +121,8 @@ func main() {
 				MinTime:             10,
 				PermitWithoutStream: true,
 			},
+			// Room for a message of the largest maximum message size
+			MaxRecvMsgSize: domain.MaxMaximumMessageSize + 1024*1024,
 		},
 	)
 	if err != nil {
@@ -100,6 +136,81 @@ func main() {
 		},
 	)
 
+	// Serve the metrics
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	metricsServer := &http.Server{Addr: metricsAddr, Handler: mux}
+	go func() {
+		if err := metricsServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Fatalf("failed to serve metrics err=%s\n", err.Error())
+		}
+	}()
+
 	// Add shutdown hook to trigger closer resources of service
-	server.AddShutdownHook(grpcServer)
+	server.AddShutdownHook(grpcServer, metricsServer, queueService)
+}
+
+// lifecycleListener counts the lifecycle events of messages, and logs them
+// when LIFECYCLE_EVENTS is set
+func lifecycleListener(config *config.Config) func(domain.LifecycleEvent) {
+	return func(event domain.LifecycleEvent) {
+		if event.Type == domain.EventMessageExpired {
+			messagesExpired.WithLabelValues(event.QueueName).Inc()
+		}
+		if config.LifecycleEvents {
+			log.Printf("lifecycle event %s: queue=%s message=%s at=%s",
+				event.Type, event.QueueName, event.MessageID, event.At.Format(time.RFC3339Nano))
+		}
+	}
+}
+
+// runMigrate applies, rolls back or lists the schema migrations of the
+// configured backend. Usage: migrate up | down [steps] | status
+func runMigrate(config *config.Config, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("usage: migrate up | down [steps] | status")
+	}
+
+	migrator, err := repository.NewMigrator(config)
+	if err != nil {
+		return err
+	}
+	defer migrator.Close()
+
+	ctx := context.Background()
+	switch args[0] {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		for _, migration := range applied {
+			log.Printf("applied migration %04d_%s", migration.Version, migration.Name)
+		}
+		return err
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			if steps, err = strconv.Atoi(args[1]); err != nil || steps < 1 {
+				return fmt.Errorf("invalid number of steps %q", args[1])
+			}
+		}
+		reverted, err := migrator.Down(ctx, steps)
+		for _, migration := range reverted {
+			log.Printf("rolled back migration %04d_%s", migration.Version, migration.Name)
+		}
+		return err
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		for _, status := range statuses {
+			applied := "pending"
+			if status.AppliedAt != nil {
+				applied = "applied at " + status.AppliedAt.Format(time.RFC3339)
+			}
+			fmt.Printf("%04d_%s\t%s\n", status.Version, status.Name, applied)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown migrate command %q", args[0])
+	}
 }
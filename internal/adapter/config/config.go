@@ -6,14 +6,111 @@ import (
 	"strconv"
 )
 
+// Storage backends selectable through STORAGE_BACKEND
+const (
+	BackendPostgres = "postgres"
+	BackendMemory   = "memory"
+	BackendSQLite   = "sqlite"
+)
+
+// DefaultSQLitePath is the database file used when SQLITE_PATH is not set
+const DefaultSQLitePath = "db/queue.db"
+
+// DefaultBlobThreshold is the body size above which messages are offloaded
+// when BLOB_THRESHOLD is not set
+const DefaultBlobThreshold = 64 * 1024
+
 type Config struct {
-	ConString string
+	Backend     string
+	ConString   string
+	SQLitePath  string
+	AutoMigrate bool
+	// LifecycleEvents logs the lifecycle events of messages, such as expiry
+	LifecycleEvents bool
+	// BlobStorePath is the directory message bodies larger than BlobThreshold
+	// bytes are offloaded to. Bodies are never offloaded when it is empty.
+	BlobStorePath string
+	BlobThreshold int
 }
 
 func NewConfig() *Config {
-	return &Config{
-		ConString: loadConString(),
+	backend := loadBackend()
+
+	config := &Config{
+		Backend:     backend,
+		AutoMigrate: loadAutoMigrate(),
+
+		LifecycleEvents: loadLifecycleEvents(),
+
+		BlobStorePath: os.Getenv("BLOB_STORE_PATH"),
+		BlobThreshold: loadBlobThreshold(),
+	}
+	switch backend {
+	case BackendPostgres:
+		config.ConString = loadConString()
+	case BackendSQLite:
+		config.SQLitePath = loadSQLitePath()
+	}
+
+	return config
+}
+
+func loadBackend() string {
+	backend := os.Getenv("STORAGE_BACKEND")
+	if backend == "" {
+		return BackendPostgres
+	}
+	return backend
+}
+
+// loadAutoMigrate reads AUTO_MIGRATE, migrations run on start unless it is false
+func loadAutoMigrate() bool {
+	value := os.Getenv("AUTO_MIGRATE")
+	if value == "" {
+		return true
+	}
+
+	autoMigrate, err := strconv.ParseBool(value)
+	if err != nil {
+		panic("error to load auto migrate flag")
+	}
+	return autoMigrate
+}
+
+// loadLifecycleEvents reads LIFECYCLE_EVENTS, events are not logged unless it is true
+func loadLifecycleEvents() bool {
+	value := os.Getenv("LIFECYCLE_EVENTS")
+	if value == "" {
+		return false
+	}
+
+	lifecycleEvents, err := strconv.ParseBool(value)
+	if err != nil {
+		panic("error to load lifecycle events flag")
+	}
+	return lifecycleEvents
+}
+
+// loadBlobThreshold reads BLOB_THRESHOLD, a number of bytes
+func loadBlobThreshold() int {
+	value := os.Getenv("BLOB_THRESHOLD")
+	if value == "" {
+		return DefaultBlobThreshold
+	}
+
+	threshold, err := strconv.Atoi(value)
+	if err != nil || threshold < 0 {
+		panic("error to load blob threshold")
+	}
+	return threshold
+}
+
+func loadSQLitePath() string {
+	path := os.Getenv("SQLITE_PATH")
+	if path == "" {
+		return DefaultSQLitePath
 	}
+	return path
 }
 
 func loadConString() string {
@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+
+	"queueserver/internal/core/domain"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// The zstd encoder and decoder are safe for concurrent EncodeAll and
+// DecodeAll calls, so every repository shares them
+var (
+	zstdEncoder, _ = zstd.NewWriter(nil)
+	zstdDecoder, _ = zstd.NewReader(nil)
+)
+
+// compressBody compresses body with codec before it is stored. It returns the
+// body as is with domain.CompressionNone when compression would not make it
+// smaller.
+func compressBody(codec string, body []byte) ([]byte, string, error) {
+	var compressed []byte
+	switch codec {
+	case domain.CompressionNone:
+		return body, domain.CompressionNone, nil
+	case domain.CompressionGzip:
+		var buf bytes.Buffer
+		writer := gzip.NewWriter(&buf)
+		if _, err := writer.Write(body); err != nil {
+			return nil, "", fmt.Errorf("failed to compress message body: %v", err)
+		}
+		if err := writer.Close(); err != nil {
+			return nil, "", fmt.Errorf("failed to compress message body: %v", err)
+		}
+		compressed = buf.Bytes()
+	case domain.CompressionZstd:
+		compressed = zstdEncoder.EncodeAll(body, make([]byte, 0, len(body)))
+	default:
+		return nil, "", fmt.Errorf("failed to compress message body: unknown codec %q", codec)
+	}
+
+	if len(compressed) >= len(body) {
+		return body, domain.CompressionNone, nil
+	}
+	return compressed, codec, nil
+}
+
+// decompressBody restores a body stored with codec
+func decompressBody(codec string, stored []byte) ([]byte, error) {
+	switch codec {
+	case domain.CompressionNone:
+		return stored, nil
+	case domain.CompressionGzip:
+		reader, err := gzip.NewReader(bytes.NewReader(stored))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decompress message body: %v", err)
+		}
+		body, err := io.ReadAll(reader)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decompress message body: %v", err)
+		}
+		return body, nil
+	case domain.CompressionZstd:
+		body, err := zstdDecoder.DecodeAll(stored, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decompress message body: %v", err)
+		}
+		return body, nil
+	default:
+		return nil, fmt.Errorf("failed to decompress message body: unknown codec %q", codec)
+	}
+}
+
+// compressMessageBody compresses the inline body of message with its codec.
+// An offloaded message has an empty inline body and keeps the codec its blob
+// was stored with.
+func compressMessageBody(message *domain.Message) ([]byte, string, error) {
+	if message.BlobKey != "" {
+		return message.Body, message.Compression, nil
+	}
+	return compressBody(message.Compression, message.Body)
+}
+
+// decompressMessageBody restores the inline body of a stored message
+func decompressMessageBody(message *domain.Message) error {
+	if message.BlobKey != "" {
+		return nil
+	}
+	body, err := decompressBody(message.Compression, message.Body)
+	if err != nil {
+		return err
+	}
+	message.Body = body
+	return nil
+}
@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+
+	"queueserver/internal/core/domain"
+)
+
+func TestCompressBody(t *testing.T) {
+	compressible := bytes.Repeat([]byte("hello world "), 1000)
+	random := make([]byte, 4096)
+	rand.New(rand.NewSource(1)).Read(random)
+
+	tests := []struct {
+		name      string
+		codec     string
+		body      []byte
+		wantCodec string
+	}{
+		{name: "none", codec: domain.CompressionNone, body: compressible, wantCodec: domain.CompressionNone},
+		{name: "gzip", codec: domain.CompressionGzip, body: compressible, wantCodec: domain.CompressionGzip},
+		{name: "zstd", codec: domain.CompressionZstd, body: compressible, wantCodec: domain.CompressionZstd},
+		{name: "gzip of random bytes", codec: domain.CompressionGzip, body: random, wantCodec: domain.CompressionNone},
+		{name: "zstd of random bytes", codec: domain.CompressionZstd, body: random, wantCodec: domain.CompressionNone},
+		{name: "gzip of an empty body", codec: domain.CompressionGzip, body: []byte{}, wantCodec: domain.CompressionNone},
+		{name: "zstd of an empty body", codec: domain.CompressionZstd, body: []byte{}, wantCodec: domain.CompressionNone},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stored, codec, err := compressBody(tt.codec, tt.body)
+			if err != nil {
+				t.Fatalf("compressBody() error = %v", err)
+			}
+			if codec != tt.wantCodec {
+				t.Errorf("compressBody() codec = %q, want %q", codec, tt.wantCodec)
+			}
+			if codec != domain.CompressionNone && len(stored) >= len(tt.body) {
+				t.Errorf("compressBody() stored %d bytes of a %d byte body", len(stored), len(tt.body))
+			}
+
+			body, err := decompressBody(codec, stored)
+			if err != nil {
+				t.Fatalf("decompressBody() error = %v", err)
+			}
+			if !bytes.Equal(body, tt.body) {
+				t.Errorf("decompressBody() did not restore the body")
+			}
+		})
+	}
+}
+
+func TestCompressionErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		compress bool
+		codec    string
+		body     []byte
+	}{
+		{name: "compress with an unknown codec", compress: true, codec: "lz4", body: []byte("body")},
+		{name: "decompress with an unknown codec", codec: "lz4", body: []byte("body")},
+		{name: "corrupt gzip body", codec: domain.CompressionGzip, body: []byte("not gzip")},
+		{name: "corrupt zstd body", codec: domain.CompressionZstd, body: []byte("not zstd")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var err error
+			if tt.compress {
+				_, _, err = compressBody(tt.codec, tt.body)
+			} else {
+				_, err = decompressBody(tt.codec, tt.body)
+			}
+			if err == nil {
+				t.Errorf("expected an error")
+			}
+		})
+	}
+}
+
+func TestCompressMessageBody(t *testing.T) {
+	body := bytes.Repeat([]byte("hello world "), 1000)
+
+	tests := []struct {
+		name      string
+		message   domain.Message
+		wantCodec string
+		wantBody  []byte
+	}{
+		{
+			name:      "inline body",
+			message:   domain.Message{Body: body, Compression: domain.CompressionZstd},
+			wantCodec: domain.CompressionZstd,
+		},
+		{
+			// The blob store compressed the offloaded body
+			name:      "offloaded body",
+			message:   domain.Message{Body: []byte{}, BlobKey: "key", Compression: domain.CompressionGzip},
+			wantCodec: domain.CompressionGzip,
+			wantBody:  []byte{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stored, codec, err := compressMessageBody(&tt.message)
+			if err != nil {
+				t.Fatalf("compressMessageBody() error = %v", err)
+			}
+			if codec != tt.wantCodec {
+				t.Errorf("compressMessageBody() codec = %q, want %q", codec, tt.wantCodec)
+			}
+			if tt.wantBody != nil && !bytes.Equal(stored, tt.wantBody) {
+				t.Errorf("compressMessageBody() = %q, want %q", stored, tt.wantBody)
+			}
+
+			loaded := tt.message
+			loaded.Body, loaded.Compression = stored, codec
+			if err := decompressMessageBody(&loaded); err != nil {
+				t.Fatalf("decompressMessageBody() error = %v", err)
+			}
+			if !bytes.Equal(loaded.Body, tt.message.Body) {
+				t.Errorf("decompressMessageBody() did not restore the body")
+			}
+		})
+	}
+}
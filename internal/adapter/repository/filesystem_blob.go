@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"queueserver/internal/adapter/config"
+	"queueserver/internal/core/domain"
+)
+
+// FilesystemBlobStore keeps each blob in a file under the BLOB_STORE_PATH
+// directory, fanned out into subdirectories named after the first two
+// characters of the key
+type FilesystemBlobStore struct {
+	root string
+}
+
+func NewFilesystemBlobStore(config *config.Config) (*FilesystemBlobStore, error) {
+	if err := os.MkdirAll(config.BlobStorePath, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create blob store directory: %v", err)
+	}
+	return &FilesystemBlobStore{root: config.BlobStorePath}, nil
+}
+
+// Put writes the compressed data to a temporary file renamed over the blob, so
+// readers never see a partial blob
+func (s *FilesystemBlobStore) Put(ctx context.Context, key string, data []byte, codec string) (string, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return "", err
+	}
+	data, codec, err = compressBody(codec, data)
+	if err != nil {
+		return "", err
+	}
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", fmt.Errorf("failed to store blob: %v", err)
+	}
+
+	file, err := os.CreateTemp(dir, ".put-*")
+	if err != nil {
+		return "", fmt.Errorf("failed to store blob: %v", err)
+	}
+	defer os.Remove(file.Name())
+
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		return "", fmt.Errorf("failed to store blob: %v", err)
+	}
+	if err := file.Close(); err != nil {
+		return "", fmt.Errorf("failed to store blob: %v", err)
+	}
+	if err := os.Rename(file.Name(), path); err != nil {
+		return "", fmt.Errorf("failed to store blob: %v", err)
+	}
+	return codec, nil
+}
+
+func (s *FilesystemBlobStore) Get(ctx context.Context, key string, codec string) ([]byte, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, domain.ErrBlobNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read blob: %v", err)
+	}
+	return decompressBody(codec, data)
+}
+
+func (s *FilesystemBlobStore) Delete(ctx context.Context, keys []string) error {
+	for _, key := range keys {
+		path, err := s.path(key)
+		if err != nil {
+			return err
+		}
+		if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("failed to delete blob: %v", err)
+		}
+	}
+	return nil
+}
+
+// List walks the whole store, temporary files of unfinished writes aside
+func (s *FilesystemBlobStore) List(ctx context.Context, storedBefore time.Time) ([]string, error) {
+	keys := make([]string, 0)
+	err := filepath.WalkDir(s.root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			return nil
+		}
+
+		info, err := entry.Info()
+		if errors.Is(err, fs.ErrNotExist) {
+			// Deleted since the directory was read
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if info.ModTime().Before(storedBefore) {
+			keys = append(keys, entry.Name())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list blobs: %v", err)
+	}
+	return keys, nil
+}
+
+// path returns the file of the blob stored under key
+func (s *FilesystemBlobStore) path(key string) (string, error) {
+	if len(key) < 2 || strings.HasPrefix(key, ".") || strings.ContainsAny(key, `/\`) {
+		return "", fmt.Errorf("invalid blob key %q", key)
+	}
+	return filepath.Join(s.root, key[:2], key), nil
+}
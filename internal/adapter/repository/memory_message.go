@@ -0,0 +1,429 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"queueserver/internal/core/domain"
+)
+
+// MemoryMessageRepository keeps the messages of every queue in a
+// domain.QueueManager. It is meant for development and CI, everything is lost
+// when the server stops. Callers always get copies, never the stored messages,
+// whose bodies are compressed with the codec of their queue.
+type MemoryMessageRepository struct {
+	mu       sync.Mutex
+	queues   map[string]*domain.QueueManager
+	byID     map[string]*domain.Message
+	sequence int64 // last assigned sequence number
+	// deduplication holds the reserved deduplication ids of each queue
+	deduplication map[string]map[string]deduplicationEntry
+	// queueRepo tells MoveNext which destination queues exist
+	queueRepo *MemoryQueueRepository
+}
+
+// deduplicationEntry is a deduplication id reserved by a message until expiresAt
+type deduplicationEntry struct {
+	messageID string
+	expiresAt time.Time
+}
+
+func NewMemoryMessageRepository(queueRepo *MemoryQueueRepository) *MemoryMessageRepository {
+	return &MemoryMessageRepository{
+		queues:        make(map[string]*domain.QueueManager),
+		byID:          make(map[string]*domain.Message),
+		deduplication: make(map[string]map[string]deduplicationEntry),
+		queueRepo:     queueRepo,
+	}
+}
+
+func (r *MemoryMessageRepository) Save(ctx context.Context, message *domain.Message) error {
+	stored, err := compressedCopy(message)
+	if err != nil {
+		return fmt.Errorf("failed to save message: %v", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.store(stored)
+	return nil
+}
+
+func (r *MemoryMessageRepository) SaveBatch(ctx context.Context, messages []*domain.Message) error {
+	stored := make([]*domain.Message, 0, len(messages))
+	for _, message := range messages {
+		copied, err := compressedCopy(message)
+		if err != nil {
+			return fmt.Errorf("failed to save messages: %v", err)
+		}
+		stored = append(stored, copied)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, message := range messages {
+		if message.Deduplication != nil {
+			if originalID := r.deduplicate(message); originalID != "" {
+				message.ID = originalID
+				continue
+			}
+		}
+		r.store(stored[i])
+	}
+	return nil
+}
+
+func (r *MemoryMessageRepository) GetByMessageID(ctx context.Context, messageId string) (*domain.Message, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return copyMessage(r.byID[messageId])
+}
+
+func (r *MemoryMessageRepository) GetByReceiptHandle(ctx context.Context, receiptHandle string) (*domain.Message, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, msg := range r.byID {
+		if msg.ReceiptHandle == receiptHandle {
+			return copyMessage(msg)
+		}
+	}
+	return nil, nil
+}
+
+func (r *MemoryMessageRepository) Claim(ctx context.Context, req domain.ClaimRequest) ([]*domain.Message, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	manager := r.manager(req.Queue.Name)
+	next := manager.Next
+	switch {
+	case req.Queue.FifoQueue:
+		next = manager.NextHead
+	case req.Queue.PriorityQueue:
+		next = func(now time.Time) *domain.Message {
+			return manager.NextByPriority(now, req.Queue.PriorityAging)
+		}
+	}
+
+	messages := make([]*domain.Message, 0, len(req.ReceiptHandles))
+	for len(messages) < len(req.ReceiptHandles) {
+		msg := next(req.Now)
+		if msg == nil {
+			break
+		}
+
+		if req.DeadLetterQueue != nil && msg.ShouldDeadLetter(req.Queue.RedrivePolicy) {
+			manager.Remove(msg.ID)
+			msg.DeadLetter(req.DeadLetterQueue.Name, req.Now)
+			r.manager(msg.QueueName).Push(msg)
+			continue
+		}
+
+		manager.Claim(msg, req.ReceiptHandles[len(messages)], req.Now, req.VisibleAt)
+		claimed, err := copyMessage(msg)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, claimed)
+	}
+	return messages, nil
+}
+
+// MoveNext skips the messages whose destination is not an existing queue and
+// numbers the moved message after the others, like the SQL backends
+func (r *MemoryMessageRepository) MoveNext(ctx context.Context, sourceQueueName string, destinationQueueName string, now time.Time) (*domain.Message, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	manager, ok := r.queues[sourceQueueName]
+	if !ok {
+		return nil, nil
+	}
+
+	msg := manager.NextWhere(now, func(msg *domain.Message) bool {
+		return r.movable(msg, destinationQueueName)
+	})
+	if msg == nil {
+		return nil, nil
+	}
+
+	manager.Remove(msg.ID)
+	msg.Redrive(destinationQueueName, now)
+	r.sequence++
+	msg.SequenceNumber = r.sequence
+	r.manager(msg.QueueName).Push(msg)
+	return copyMessage(msg)
+}
+
+func (r *MemoryMessageRepository) CountMovable(ctx context.Context, sourceQueueName string, destinationQueueName string, now time.Time) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	manager, ok := r.queues[sourceQueueName]
+	if !ok {
+		return 0, nil
+	}
+
+	var count int64
+	countMovable := func(msg *domain.Message) {
+		if !msg.VisibilityTimeout.After(now) && r.movable(msg, destinationQueueName) {
+			count++
+		}
+	}
+	for _, msg := range manager.Ready {
+		countMovable(msg)
+	}
+	for _, msg := range manager.InFlight {
+		countMovable(msg)
+	}
+	return count, nil
+}
+
+// movable reports whether the destination of msg is an existing queue
+func (r *MemoryMessageRepository) movable(msg *domain.Message, destinationQueueName string) bool {
+	if destinationQueueName != "" {
+		return r.queueRepo.exists(destinationQueueName)
+	}
+	return msg.SourceQueueName != "" && r.queueRepo.exists(msg.SourceQueueName)
+}
+
+func (r *MemoryMessageRepository) NextVisibleAt(ctx context.Context, queueName string, now time.Time) (time.Time, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	manager, ok := r.queues[queueName]
+	if !ok {
+		return time.Time{}, nil
+	}
+	return manager.NextVisibleAt(now), nil
+}
+
+func (r *MemoryMessageRepository) CountByQueue(ctx context.Context, queueName string, now time.Time) (domain.QueueStats, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	manager, ok := r.queues[queueName]
+	if !ok {
+		return domain.QueueStats{}, nil
+	}
+	return manager.Stats(now), nil
+}
+
+func (r *MemoryMessageRepository) ChangeVisibility(ctx context.Context, queueName string, receiptHandle string, now time.Time, visibleAt time.Time) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	manager, ok := r.queues[queueName]
+	if !ok {
+		return false, nil
+	}
+	return manager.ChangeVisibility(receiptHandle, now, visibleAt), nil
+}
+
+func (r *MemoryMessageRepository) Delete(ctx context.Context, messageId string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.remove(messageId)
+	return nil
+}
+
+func (r *MemoryMessageRepository) DeleteByReceiptHandle(ctx context.Context, queueName string, receiptHandle string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	manager, ok := r.queues[queueName]
+	if !ok {
+		return false, nil
+	}
+
+	msg := manager.FindByReceiptHandle(receiptHandle)
+	if msg == nil {
+		return false, nil
+	}
+
+	r.remove(msg.ID)
+	return true, nil
+}
+
+func (r *MemoryMessageRepository) DeleteByReceiptHandles(ctx context.Context, queueName string, receiptHandles []string) ([]string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	deleted := make([]string, 0, len(receiptHandles))
+	manager, ok := r.queues[queueName]
+	if !ok {
+		return deleted, nil
+	}
+
+	for _, handle := range receiptHandles {
+		msg := manager.FindByReceiptHandle(handle)
+		if msg == nil {
+			continue
+		}
+		r.remove(msg.ID)
+		deleted = append(deleted, handle)
+	}
+	return deleted, nil
+}
+
+func (r *MemoryMessageRepository) DeleteExpired(ctx context.Context, queueName string, sentBefore time.Time, limit int) ([]*domain.Message, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	expired := make([]*domain.Message, 0)
+	manager, ok := r.queues[queueName]
+	if !ok {
+		return expired, nil
+	}
+
+	for _, msg := range manager.SentBefore(sentBefore) {
+		if len(expired) == limit {
+			break
+		}
+		r.remove(msg.ID)
+		expired = append(expired, msg)
+	}
+	return expired, nil
+}
+
+func (r *MemoryMessageRepository) PurgeDeduplication(ctx context.Context, now time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for queueName, reserved := range r.deduplication {
+		for id, entry := range reserved {
+			if !entry.expiresAt.After(now) {
+				delete(reserved, id)
+			}
+		}
+		if len(reserved) == 0 {
+			delete(r.deduplication, queueName)
+		}
+	}
+	return nil
+}
+
+func (r *MemoryMessageRepository) DeleteByQueueName(ctx context.Context, queueName string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.deduplication, queueName)
+	manager, ok := r.queues[queueName]
+	if !ok {
+		return nil
+	}
+
+	for _, msg := range manager.Ready {
+		delete(r.byID, msg.ID)
+	}
+	for id := range manager.InFlight {
+		delete(r.byID, id)
+	}
+	delete(r.queues, queueName)
+	return nil
+}
+
+func (r *MemoryMessageRepository) ReferencedBlobKeys(ctx context.Context, keys []string) ([]string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	referenced := make([]string, 0)
+	for _, key := range keys {
+		if msg, ok := r.byID[key]; ok && msg.BlobKey == key {
+			referenced = append(referenced, key)
+		}
+	}
+	return referenced, nil
+}
+
+// manager returns the container of the named queue, creating it on first use.
+// Callers must hold r.mu.
+func (r *MemoryMessageRepository) manager(queueName string) *domain.QueueManager {
+	manager, ok := r.queues[queueName]
+	if !ok {
+		manager = domain.NewQueueManager(queueName)
+		r.queues[queueName] = manager
+	}
+	return manager
+}
+
+// deduplicate reserves the deduplication id of message. It returns the ID of
+// the original message when an earlier send still holds the id; an expired
+// reservation is taken over. Callers must hold r.mu.
+func (r *MemoryMessageRepository) deduplicate(message *domain.Message) string {
+	reserved, ok := r.deduplication[message.QueueName]
+	if !ok {
+		reserved = make(map[string]deduplicationEntry)
+		r.deduplication[message.QueueName] = reserved
+	}
+
+	if entry, ok := reserved[message.Deduplication.ID]; ok && entry.expiresAt.After(message.SentAt) {
+		return entry.messageID
+	}
+	reserved[message.Deduplication.ID] = deduplicationEntry{
+		messageID: message.ID,
+		expiresAt: message.Deduplication.ExpiresAt,
+	}
+	return ""
+}
+
+// store saves a copy made by compressedCopy, numbering it when it is new.
+// Callers must hold r.mu.
+func (r *MemoryMessageRepository) store(stored *domain.Message) {
+	if existing, ok := r.byID[stored.ID]; ok {
+		stored.SequenceNumber = existing.SequenceNumber
+	} else {
+		r.sequence++
+		stored.SequenceNumber = r.sequence
+	}
+
+	r.remove(stored.ID)
+	r.byID[stored.ID] = stored
+	r.manager(stored.QueueName).Push(stored)
+}
+
+// remove drops a message from the indexes. Callers must hold r.mu.
+func (r *MemoryMessageRepository) remove(messageID string) {
+	msg, ok := r.byID[messageID]
+	if !ok {
+		return
+	}
+
+	delete(r.byID, messageID)
+	if manager, ok := r.queues[msg.QueueName]; ok {
+		manager.Remove(messageID)
+		if manager.Len() == 0 {
+			delete(r.queues, msg.QueueName)
+		}
+	}
+}
+
+func copyMessage(message *domain.Message) (*domain.Message, error) {
+	if message == nil {
+		return nil, nil
+	}
+	found := *message
+	if err := decompressMessageBody(&found); err != nil {
+		return nil, err
+	}
+	return &found, nil
+}
+
+// compressedCopy returns the copy of message to store, its body compressed
+// with the codec of the message
+func compressedCopy(message *domain.Message) (*domain.Message, error) {
+	stored := *message
+	body, compression, err := compressMessageBody(message)
+	if err != nil {
+		return nil, err
+	}
+	stored.Body, stored.Compression = body, compression
+	return &stored, nil
+}
@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"queueserver/internal/core/domain"
+)
+
+// MemoryQueueRepository keeps the queues in process memory. It is meant for
+// development and CI, everything is lost when the server stops.
+type MemoryQueueRepository struct {
+	mu     sync.RWMutex
+	queues map[string]*domain.Queue
+}
+
+func NewMemoryQueueRepository() *MemoryQueueRepository {
+	return &MemoryQueueRepository{
+		queues: make(map[string]*domain.Queue),
+	}
+}
+
+func (r *MemoryQueueRepository) Save(ctx context.Context, queue *domain.Queue) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.queues[queue.Name]; ok {
+		return fmt.Errorf("failed to save queue: queue %q already exists", queue.Name)
+	}
+	if queue.CreatedAt.IsZero() {
+		queue.CreatedAt = time.Now()
+	}
+
+	stored := *queue
+	r.queues[queue.Name] = &stored
+	return nil
+}
+
+func (r *MemoryQueueRepository) Update(ctx context.Context, queue *domain.Queue) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	existing, ok := r.queues[queue.Name]
+	if !ok {
+		return domain.ErrQueueNotFound
+	}
+
+	stored := *queue
+	stored.CreatedAt = existing.CreatedAt
+	r.queues[queue.Name] = &stored
+	return nil
+}
+
+func (r *MemoryQueueRepository) GetByName(ctx context.Context, name string) (*domain.Queue, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	queue, ok := r.queues[name]
+	if !ok {
+		return nil, nil
+	}
+
+	found := *queue
+	return &found, nil
+}
+
+// exists reports whether a queue named name is stored
+func (r *MemoryQueueRepository) exists(name string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	_, ok := r.queues[name]
+	return ok
+}
+
+func (r *MemoryQueueRepository) List(ctx context.Context, prefix string, startAfter string, limit int) ([]*domain.Queue, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	names := make([]string, 0, len(r.queues))
+	for name := range r.queues {
+		if strings.HasPrefix(name, prefix) && name > startAfter {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	if len(names) > limit {
+		names = names[:limit]
+	}
+
+	queues := make([]*domain.Queue, 0, len(names))
+	for _, name := range names {
+		queue := *r.queues[name]
+		queues = append(queues, &queue)
+	}
+	return queues, nil
+}
+
+func (r *MemoryQueueRepository) Delete(ctx context.Context, name string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.queues, name)
+	return nil
+}
@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"queueserver/internal/core/domain"
+)
+
+func TestMemoryQueueRepositoryUpdate(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMemoryQueueRepository()
+	if err := repo.Save(ctx, domain.NewQueue("queue")); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		queue   string
+		wantErr error
+	}{
+		{name: "existing queue", queue: "queue"},
+		{name: "missing queue", queue: "missing", wantErr: domain.ErrQueueNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			queue := domain.NewQueue(tt.queue)
+			queue.VisibilityTimeout = time.Minute
+			if err := repo.Update(ctx, queue); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Update() error = %v, want %v", err, tt.wantErr)
+			}
+
+			stored, err := repo.GetByName(ctx, tt.queue)
+			if err != nil {
+				t.Fatalf("GetByName() error = %v", err)
+			}
+			if tt.wantErr != nil {
+				if stored != nil {
+					t.Errorf("Update() stored the missing queue %q", tt.queue)
+				}
+				return
+			}
+			if stored.VisibilityTimeout != time.Minute {
+				t.Errorf("VisibilityTimeout = %s, want %s", stored.VisibilityTimeout, time.Minute)
+			}
+		})
+	}
+}
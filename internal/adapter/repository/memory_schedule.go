@@ -0,0 +1,128 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"queueserver/internal/core/domain"
+)
+
+// MemoryScheduleRepository keeps the schedules in process memory and fires
+// them into a MemoryMessageRepository. It is meant for development and CI,
+// everything is lost when the server stops.
+type MemoryScheduleRepository struct {
+	mu        sync.Mutex
+	schedules map[string]*domain.Schedule
+	messages  *MemoryMessageRepository
+}
+
+func NewMemoryScheduleRepository(messages *MemoryMessageRepository) *MemoryScheduleRepository {
+	return &MemoryScheduleRepository{
+		schedules: make(map[string]*domain.Schedule),
+		messages:  messages,
+	}
+}
+
+func (r *MemoryScheduleRepository) Save(ctx context.Context, schedule *domain.Schedule) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored := *schedule
+	r.schedules[schedule.ID] = &stored
+	return nil
+}
+
+func (r *MemoryScheduleRepository) GetByID(ctx context.Context, id string) (*domain.Schedule, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return copySchedule(r.schedules[id]), nil
+}
+
+func (r *MemoryScheduleRepository) ListByQueue(ctx context.Context, queueName string) ([]*domain.Schedule, error) {
+	return r.list(func(schedule *domain.Schedule) bool {
+		return schedule.QueueName == queueName
+	}, 0), nil
+}
+
+func (r *MemoryScheduleRepository) Due(ctx context.Context, now time.Time, limit int) ([]*domain.Schedule, error) {
+	return r.list(func(schedule *domain.Schedule) bool {
+		return !schedule.NextFireAt.After(now)
+	}, limit), nil
+}
+
+func (r *MemoryScheduleRepository) Fire(ctx context.Context, schedule *domain.Schedule, message *domain.Message, last bool) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.schedules[schedule.ID]
+	if !ok || stored.FireCount != schedule.FireCount-1 {
+		return false, nil
+	}
+
+	if err := r.messages.SaveBatch(ctx, []*domain.Message{message}); err != nil {
+		return false, err
+	}
+	if last {
+		delete(r.schedules, schedule.ID)
+	} else {
+		r.schedules[schedule.ID] = copySchedule(schedule)
+	}
+	return true, nil
+}
+
+func (r *MemoryScheduleRepository) Delete(ctx context.Context, id string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	_, ok := r.schedules[id]
+	delete(r.schedules, id)
+	return ok, nil
+}
+
+func (r *MemoryScheduleRepository) DeleteByQueueName(ctx context.Context, queueName string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for id, schedule := range r.schedules {
+		if schedule.QueueName == queueName {
+			delete(r.schedules, id)
+		}
+	}
+	return nil
+}
+
+// list returns copies of the schedules matching match, earliest next fire
+// first, at most limit of them unless limit is 0
+func (r *MemoryScheduleRepository) list(match func(*domain.Schedule) bool, limit int) []*domain.Schedule {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	schedules := make([]*domain.Schedule, 0)
+	for _, schedule := range r.schedules {
+		if match(schedule) {
+			schedules = append(schedules, copySchedule(schedule))
+		}
+	}
+	sort.Slice(schedules, func(i, j int) bool {
+		if !schedules[i].NextFireAt.Equal(schedules[j].NextFireAt) {
+			return schedules[i].NextFireAt.Before(schedules[j].NextFireAt)
+		}
+		return schedules[i].ID < schedules[j].ID
+	})
+
+	if limit > 0 && len(schedules) > limit {
+		schedules = schedules[:limit]
+	}
+	return schedules
+}
+
+func copySchedule(schedule *domain.Schedule) *domain.Schedule {
+	if schedule == nil {
+		return nil
+	}
+	copied := *schedule
+	return &copied
+}
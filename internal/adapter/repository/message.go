@@ -3,53 +3,162 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"queueserver/internal/adapter/config"
 	"queueserver/internal/core/domain"
 
-	_ "github.com/lib/pq"
+	"github.com/lib/pq"
 )
 
+// messageInsertColumns leaves out the sequence number, which the database assigns
+const messageInsertColumns = `id, body, receipt_handle, visibility_timeout, queue_name, sent_at,
+              receive_count, first_received_at, source_queue_name, message_group_id, priority,
+              message_attributes, content_type, content_encoding, blob_key, body_compression`
+
+// messageInsertColumnCount is the number of messageInsertColumns
+const messageInsertColumnCount = 16
+
+const messageColumns = messageInsertColumns + `, sequence_number`
+
 type PostgresMessageRepository struct {
 	db *sql.DB
 }
 
 func NewPostgresMessageRepository(config *config.Config) (*PostgresMessageRepository, error) {
-	db, err := sql.Open("postgres", config.ConString)
+	db, err := openPostgres(config.ConString)
 	if err != nil {
-		return nil, fmt.Errorf("failed to connect to database: %v", err)
-	}
-
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-
-	if err := db.PingContext(ctx); err != nil {
-		return nil, fmt.Errorf("failed to ping database: %v", err)
+		return nil, err
 	}
 
 	return &PostgresMessageRepository{db: db}, nil
 }
 
 func (r *PostgresMessageRepository) Save(ctx context.Context, message *domain.Message) error {
-	query := `INSERT INTO messages (id, body, receipt_handle, visibility_timeout, queue_name) 
-              VALUES ($1, $2, $3, $4, $5) ON CONFLICT (id) DO UPDATE 
+	query := `INSERT INTO messages (` + messageInsertColumns + `) 
+              VALUES ` + valuesPlaceholders("$", 1, messageInsertColumnCount) + ` ON CONFLICT (id) DO UPDATE 
               SET body = EXCLUDED.body, receipt_handle = EXCLUDED.receipt_handle, 
-                  visibility_timeout = EXCLUDED.visibility_timeout, queue_name = EXCLUDED.queue_name`
-	_, err := r.db.ExecContext(ctx, query, message.ID, message.Body, message.ReceiptHandle, message.VisibilityTimeout, message.QueueName)
+                  visibility_timeout = EXCLUDED.visibility_timeout, queue_name = EXCLUDED.queue_name,
+                  receive_count = EXCLUDED.receive_count, first_received_at = EXCLUDED.first_received_at,
+                  source_queue_name = EXCLUDED.source_queue_name, message_group_id = EXCLUDED.message_group_id,
+                  priority = EXCLUDED.priority, message_attributes = EXCLUDED.message_attributes,
+                  content_type = EXCLUDED.content_type, content_encoding = EXCLUDED.content_encoding,
+                  blob_key = EXCLUDED.blob_key, body_compression = EXCLUDED.body_compression`
+	values, err := messageValues(message)
+	if err != nil {
+		return err
+	}
+	_, err = r.db.ExecContext(ctx, query, values...)
 	if err != nil {
 		return fmt.Errorf("failed to save message: %v", err)
 	}
 	return nil
 }
 
-func (r *PostgresMessageRepository) GetByID(ctx context.Context, id string) (*domain.Message, error) {
-	query := `SELECT id, body, receipt_handle, visibility_timeout, queue_name FROM messages WHERE id = $1`
+// SaveBatch inserts new messages with a single multi-row INSERT statement.
+// When some carry a deduplication id, their ids are first reserved in the
+// same transaction. A concurrent send of the same id waits on the reservation
+// row until this one commits, so only one of them is inserted.
+func (r *PostgresMessageRepository) SaveBatch(ctx context.Context, messages []*domain.Message) error {
+	if !hasDeduplication(messages) {
+		return insertMessages(ctx, r.db, messages)
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to save messages: %v", err)
+	}
+	defer tx.Rollback()
+
+	holders, err := r.deduplicate(ctx, tx, messages)
+	if err != nil {
+		return err
+	}
+	if err := insertMessages(ctx, tx, applyDeduplication(messages, holders)); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to save messages: %v", err)
+	}
+	return nil
+}
+
+// deduplicate reserves the deduplication ids of messages with a single
+// upsert, which also takes over the expired ids. It returns the ID of the
+// message holding each id: the message of the batch that reserved it, or the
+// original message of an earlier send.
+func (r *PostgresMessageRepository) deduplicate(ctx context.Context, tx *sql.Tx, messages []*domain.Message) (map[string]string, error) {
+	candidates := deduplicationCandidates(messages)
+	args := make([]any, 0, len(candidates)*4+1)
+	for _, message := range candidates {
+		args = append(args, message.QueueName, message.Deduplication.ID, message.ID, message.Deduplication.ExpiresAt)
+	}
+	args = append(args, messages[0].SentAt)
+
+	query := `INSERT INTO message_deduplication (queue_name, deduplication_id, message_id, expires_at)
+              VALUES ` + valuesPlaceholders("$", len(candidates), 4) + `
+              ON CONFLICT (queue_name, deduplication_id) DO UPDATE
+              SET message_id = EXCLUDED.message_id, expires_at = EXCLUDED.expires_at
+              WHERE message_deduplication.expires_at <= $` + strconv.Itoa(len(args)) + `
+              RETURNING deduplication_id, message_id`
+	holders := make(map[string]string, len(candidates))
+	if err := queryHolders(ctx, tx, holders, query, args...); err != nil {
+		return nil, err
+	}
+
+	// The ids still held by earlier sends were left alone by the upsert. This
+	// statement sees the rows committed while the upsert waited on them.
+	held := heldDeduplicationIDs(candidates, holders)
+	if len(held) == 0 {
+		return holders, nil
+	}
+	query = `SELECT deduplication_id, message_id FROM message_deduplication
+              WHERE queue_name = $1 AND deduplication_id = ANY($2)`
+	if err := queryHolders(ctx, tx, holders, query, messages[0].QueueName, pq.Array(held)); err != nil {
+		return nil, err
+	}
+	if len(heldDeduplicationIDs(candidates, holders)) > 0 {
+		// The queue was deleted meanwhile
+		return nil, fmt.Errorf("%w: the reservation of an earlier send disappeared", domain.ErrDeduplicationConflict)
+	}
+	return holders, nil
+}
+
+// insertMessages adds messages with a single multi-row INSERT statement
+func insertMessages(ctx context.Context, db execer, messages []*domain.Message) error {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	args := make([]any, 0, len(messages)*messageInsertColumnCount)
+	for _, message := range messages {
+		values, err := messageValues(message)
+		if err != nil {
+			return err
+		}
+		args = append(args, values...)
+	}
+
+	// Rows are numbered in the order of the VALUES list
+	query := `INSERT INTO messages (` + messageInsertColumns + `) VALUES ` +
+		valuesPlaceholders("$", len(messages), messageInsertColumnCount)
+	if _, err := db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to save messages: %v", err)
+	}
+	return nil
+}
+
+func (r *PostgresMessageRepository) GetByMessageID(ctx context.Context, id string) (*domain.Message, error) {
+	query := `SELECT ` + messageColumns + ` FROM messages WHERE id = $1`
 	row := r.db.QueryRowContext(ctx, query, id)
 
-	message := &domain.Message{}
-	if err := row.Scan(&message.ID, &message.Body, &message.ReceiptHandle, &message.VisibilityTimeout, &message.QueueName); err != nil {
+	message, err := scanMessage(row)
+	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
 		}
@@ -58,6 +167,205 @@ func (r *PostgresMessageRepository) GetByID(ctx context.Context, id string) (*do
 	return message, nil
 }
 
+// Claim delivers up to len(req.ReceiptHandles) of the oldest visible messages
+// of the queue. When the queue has a dead-letter queue, visible messages that
+// exhausted the redrive policy are first moved there in the same transaction.
+// On FIFO queues only the oldest message of each message group is claimed.
+// A single statement sets the receipt handles, visibility deadline and receive
+// counters of every claimed message, and rows locked by a concurrent claim are
+// skipped, so replicas sharing the database never hand out the same message.
+func (r *PostgresMessageRepository) Claim(ctx context.Context, req domain.ClaimRequest) ([]*domain.Message, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim message: %v", err)
+	}
+	defer tx.Rollback()
+
+	maxReceiveCount := 0
+	if req.DeadLetterQueue != nil {
+		maxReceiveCount = req.Queue.RedrivePolicy.MaxReceiveCount
+
+		query := `UPDATE messages SET queue_name = $2, visibility_timeout = $3,
+                      source_queue_name = CASE WHEN source_queue_name = '' THEN queue_name ELSE source_queue_name END
+                  WHERE id IN (
+                      SELECT id FROM messages
+                      WHERE queue_name = $1 AND visibility_timeout <= $3 AND receive_count >= $4
+                      FOR UPDATE SKIP LOCKED
+                  )`
+		if _, err := tx.ExecContext(ctx, query, req.Queue.Name, req.DeadLetterQueue.Name, req.Now, maxReceiveCount); err != nil {
+			return nil, fmt.Errorf("failed to move messages to the dead-letter queue: %v", err)
+		}
+	}
+
+	// Priority queues order by the priority key, the others by sequence number
+	var priorityAging int64
+	if req.Queue.PriorityQueue {
+		priorityAging = int64(req.Queue.PriorityAging / time.Second)
+	}
+
+	// The claimed messages are numbered in delivery order, and each takes the
+	// receipt handle at its position
+	query := `WITH candidates AS (
+                  SELECT m.id, m.sequence_number,
+                         CASE WHEN $7 > 0 THEN m.sent_at - m.priority * $7 * interval '1 second' END AS priority_key
+                  FROM messages m
+                  WHERE m.queue_name = $1 AND m.visibility_timeout <= $3 AND ($5 = 0 OR m.receive_count < $5)
+                      AND (NOT $6 OR NOT EXISTS (
+                          SELECT 1 FROM messages earlier
+                          WHERE earlier.queue_name = m.queue_name AND earlier.message_group_id = m.message_group_id
+                              AND earlier.sequence_number < m.sequence_number
+                      ))
+                  ORDER BY priority_key, m.sequence_number
+                  LIMIT $8
+                  FOR UPDATE SKIP LOCKED
+              ), claimed AS (
+                  SELECT id AS claimed_id, row_number() OVER (ORDER BY priority_key, sequence_number) AS position
+                  FROM candidates
+              )
+              UPDATE messages SET receipt_handle = ($2::text[])[claimed.position::int], visibility_timeout = $4,
+                  receive_count = receive_count + 1, first_received_at = COALESCE(first_received_at, $3)
+              FROM claimed
+              WHERE messages.id = claimed.claimed_id
+              RETURNING ` + messageColumns
+	rows, err := tx.QueryContext(ctx, query, req.Queue.Name, pq.Array(req.ReceiptHandles), req.Now, req.VisibleAt, maxReceiveCount,
+		req.Queue.FifoQueue, priorityAging, len(req.ReceiptHandles))
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim message: %v", err)
+	}
+	defer rows.Close()
+
+	messages := make([]*domain.Message, 0, len(req.ReceiptHandles))
+	for rows.Next() {
+		message, err := scanMessage(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to claim message: %v", err)
+		}
+		messages = append(messages, message)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to claim message: %v", err)
+	}
+	sortClaimed(messages, req.ReceiptHandles)
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to claim message: %v", err)
+	}
+	return messages, nil
+}
+
+// sortClaimed puts claimed messages back in delivery order, the order of their
+// receipt handles, which RETURNING does not guarantee
+func sortClaimed(messages []*domain.Message, receiptHandles []string) {
+	positions := make(map[string]int, len(receiptHandles))
+	for i, receiptHandle := range receiptHandles {
+		positions[receiptHandle] = i
+	}
+	sort.Slice(messages, func(i, j int) bool {
+		return positions[messages[i].ReceiptHandle] < positions[messages[j].ReceiptHandle]
+	})
+}
+
+// MoveNext redrives the oldest visible message of sourceQueueName. Messages
+// whose destination is not an existing queue are skipped: without a
+// destination, those with no source queue or whose source queue was deleted.
+// A moved message is numbered after the messages already in its new queue.
+func (r *PostgresMessageRepository) MoveNext(ctx context.Context, sourceQueueName string, destinationQueueName string, now time.Time) (*domain.Message, error) {
+	query := `UPDATE messages SET queue_name = CASE WHEN $2 = '' THEN source_queue_name ELSE $2 END,
+                  source_queue_name = '', visibility_timeout = $3, receive_count = 0, first_received_at = NULL,
+                  sequence_number = nextval('messages_sequence_number_seq')
+              WHERE id = (
+                  SELECT id FROM messages
+                  WHERE queue_name = $1 AND visibility_timeout <= $3 AND EXISTS (
+                      SELECT 1 FROM queues
+                      WHERE queues.name = CASE WHEN $2 = '' THEN messages.source_queue_name ELSE $2 END
+                  )
+                  ORDER BY sequence_number
+                  LIMIT 1
+                  FOR UPDATE SKIP LOCKED
+              )
+              RETURNING ` + messageColumns
+	row := r.db.QueryRowContext(ctx, query, sourceQueueName, destinationQueueName, now)
+
+	message, err := scanMessage(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to move message: %v", err)
+	}
+	return message, nil
+}
+
+func (r *PostgresMessageRepository) CountMovable(ctx context.Context, sourceQueueName string, destinationQueueName string, now time.Time) (int64, error) {
+	query := `SELECT COUNT(*) FROM messages
+              WHERE queue_name = $1 AND visibility_timeout <= $3 AND EXISTS (
+                  SELECT 1 FROM queues
+                  WHERE queues.name = CASE WHEN $2 = '' THEN messages.source_queue_name ELSE $2 END
+              )`
+
+	var count int64
+	if err := r.db.QueryRowContext(ctx, query, sourceQueueName, destinationQueueName, now).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count messages to move: %v", err)
+	}
+	return count, nil
+}
+
+func (r *PostgresMessageRepository) GetByReceiptHandle(ctx context.Context, receiptHandle string) (*domain.Message, error) {
+	query := `SELECT ` + messageColumns + ` FROM messages WHERE receipt_handle = $1`
+	row := r.db.QueryRowContext(ctx, query, receiptHandle)
+
+	message, err := scanMessage(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get message: %v", err)
+	}
+	return message, nil
+}
+
+func (r *PostgresMessageRepository) NextVisibleAt(ctx context.Context, queueName string, now time.Time) (time.Time, error) {
+	query := `SELECT MIN(visibility_timeout) FROM messages WHERE queue_name = $1 AND visibility_timeout > $2`
+
+	var next sql.NullTime
+	if err := r.db.QueryRowContext(ctx, query, queueName, now).Scan(&next); err != nil {
+		return time.Time{}, fmt.Errorf("failed to get the next visibility timeout: %v", err)
+	}
+	return next.Time, nil
+}
+
+// CountByQueue returns the number of visible, in-flight and delayed messages
+// of queueName at now. Hidden messages that were never received are delayed.
+func (r *PostgresMessageRepository) CountByQueue(ctx context.Context, queueName string, now time.Time) (domain.QueueStats, error) {
+	query := `SELECT COUNT(*) FILTER (WHERE visibility_timeout <= $2),
+                     COUNT(*) FILTER (WHERE visibility_timeout > $2 AND receive_count > 0),
+                     COUNT(*) FILTER (WHERE visibility_timeout > $2 AND receive_count = 0)
+              FROM messages WHERE queue_name = $1`
+
+	stats := domain.QueueStats{}
+	err := r.db.QueryRowContext(ctx, query, queueName, now).Scan(&stats.Visible, &stats.NotVisible, &stats.Delayed)
+	if err != nil {
+		return stats, fmt.Errorf("failed to count messages: %v", err)
+	}
+	return stats, nil
+}
+
+// ChangeVisibility moves the visibility deadline of an in-flight message, a
+// message that is visible again or was never received is left untouched
+func (r *PostgresMessageRepository) ChangeVisibility(ctx context.Context, queueName string, receiptHandle string, now time.Time, visibleAt time.Time) (bool, error) {
+	query := `UPDATE messages SET visibility_timeout = $4
+              WHERE queue_name = $1 AND receipt_handle = $2 AND receive_count > 0 AND visibility_timeout > $3`
+	result, err := r.db.ExecContext(ctx, query, queueName, receiptHandle, now, visibleAt)
+	if err != nil {
+		return false, fmt.Errorf("failed to change message visibility: %v", err)
+	}
+	changed, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to change message visibility: %v", err)
+	}
+	return changed > 0, nil
+}
+
 func (r *PostgresMessageRepository) Delete(ctx context.Context, id string) error {
 	query := `DELETE FROM messages WHERE id = $1`
 	_, err := r.db.ExecContext(ctx, query, id)
@@ -66,3 +374,306 @@ func (r *PostgresMessageRepository) Delete(ctx context.Context, id string) error
 	}
 	return nil
 }
+
+// DeleteByReceiptHandle deletes the message of queueName holding receiptHandle
+// and reports whether a message was deleted
+func (r *PostgresMessageRepository) DeleteByReceiptHandle(ctx context.Context, queueName string, receiptHandle string) (bool, error) {
+	query := `DELETE FROM messages WHERE queue_name = $1 AND receipt_handle = $2`
+	result, err := r.db.ExecContext(ctx, query, queueName, receiptHandle)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete message: %v", err)
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to delete message: %v", err)
+	}
+	return deleted > 0, nil
+}
+
+// DeleteByReceiptHandles deletes the messages of queueName holding any of
+// receiptHandles with a single statement and returns the handles it deleted
+func (r *PostgresMessageRepository) DeleteByReceiptHandles(ctx context.Context, queueName string, receiptHandles []string) ([]string, error) {
+	query := `DELETE FROM messages WHERE queue_name = $1 AND receipt_handle = ANY($2) RETURNING receipt_handle`
+	rows, err := r.db.QueryContext(ctx, query, queueName, pq.Array(receiptHandles))
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete messages: %v", err)
+	}
+	return scanReceiptHandles(rows)
+}
+
+// DeleteExpired deletes the oldest messages of queueName sent before
+// sentBefore, skipping those locked by a concurrent claim
+func (r *PostgresMessageRepository) DeleteExpired(ctx context.Context, queueName string, sentBefore time.Time, limit int) ([]*domain.Message, error) {
+	query := `DELETE FROM messages WHERE id IN (
+                  SELECT id FROM messages WHERE queue_name = $1 AND sent_at < $2
+                  ORDER BY sequence_number LIMIT $3
+                  FOR UPDATE SKIP LOCKED
+              )
+              RETURNING ` + messageColumns
+	rows, err := r.db.QueryContext(ctx, query, queueName, sentBefore, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete expired messages: %v", err)
+	}
+	defer rows.Close()
+
+	messages := make([]*domain.Message, 0)
+	for rows.Next() {
+		message, err := scanMessage(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to delete expired messages: %v", err)
+		}
+		messages = append(messages, message)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to delete expired messages: %v", err)
+	}
+	return messages, nil
+}
+
+func (r *PostgresMessageRepository) PurgeDeduplication(ctx context.Context, now time.Time) error {
+	query := `DELETE FROM message_deduplication WHERE expires_at <= $1`
+	if _, err := r.db.ExecContext(ctx, query, now); err != nil {
+		return fmt.Errorf("failed to purge deduplication ids: %v", err)
+	}
+	return nil
+}
+
+func (r *PostgresMessageRepository) DeleteByQueueName(ctx context.Context, queueName string) error {
+	query := `DELETE FROM messages WHERE queue_name = $1`
+	_, err := r.db.ExecContext(ctx, query, queueName)
+	if err != nil {
+		return fmt.Errorf("failed to delete queue messages: %v", err)
+	}
+
+	query = `DELETE FROM message_deduplication WHERE queue_name = $1`
+	if _, err := r.db.ExecContext(ctx, query, queueName); err != nil {
+		return fmt.Errorf("failed to delete queue deduplication ids: %v", err)
+	}
+	return nil
+}
+
+// ReferencedBlobKeys looks keys up by primary key, as bodies are offloaded
+// under the ID of their message
+func (r *PostgresMessageRepository) ReferencedBlobKeys(ctx context.Context, keys []string) ([]string, error) {
+	query := `SELECT blob_key FROM messages WHERE id = ANY($1) AND blob_key = id`
+	rows, err := r.db.QueryContext(ctx, query, pq.Array(keys))
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up blob keys: %v", err)
+	}
+	return scanBlobKeys(rows)
+}
+
+// messageValues returns the values of messageInsertColumns for message, its
+// body compressed with the codec of the message
+func messageValues(message *domain.Message) ([]any, error) {
+	body, compression, err := compressMessageBody(message)
+	if err != nil {
+		return nil, err
+	}
+	return []any{message.ID, body, message.ReceiptHandle, message.VisibilityTimeout, message.QueueName, message.SentAt,
+		message.ReceiveCount, nullTime(message.FirstReceivedAt), message.SourceQueueName, message.MessageGroupID, message.Priority,
+		encodeAttributes(message.Attributes), message.ContentType, message.ContentEncoding, message.BlobKey, compression}, nil
+}
+
+func scanMessage(row rowScanner) (*domain.Message, error) {
+	var firstReceivedAt sql.NullTime
+	var attributes []byte
+	message := &domain.Message{}
+	err := row.Scan(&message.ID, &message.Body, &message.ReceiptHandle, &message.VisibilityTimeout,
+		&message.QueueName, &message.SentAt, &message.ReceiveCount, &firstReceivedAt, &message.SourceQueueName,
+		&message.MessageGroupID, &message.Priority, &attributes, &message.ContentType, &message.ContentEncoding,
+		&message.BlobKey, &message.Compression, &message.SequenceNumber)
+	if err != nil {
+		return nil, err
+	}
+	message.FirstReceivedAt = firstReceivedAt.Time
+	if err := decompressMessageBody(message); err != nil {
+		return nil, err
+	}
+	if err := decodeAttributes(attributes, &message.Attributes); err != nil {
+		return nil, err
+	}
+	return message, nil
+}
+
+// storedAttribute is the JSON form of a message attribute in the
+// message_attributes column
+type storedAttribute struct {
+	DataType    string `json:"type"`
+	StringValue string `json:"string,omitempty"`
+	BinaryValue []byte `json:"binary,omitempty"`
+}
+
+// encodeAttributes returns the JSON object stored for attrs
+func encodeAttributes(attrs domain.MessageAttributes) string {
+	stored := make(map[string]storedAttribute, len(attrs))
+	for name, attr := range attrs {
+		stored[name] = storedAttribute(attr)
+	}
+	// Marshalling strings and byte slices cannot fail
+	encoded, _ := json.Marshal(stored)
+	return string(encoded)
+}
+
+// decodeAttributes reads a stored JSON object into attrs, leaving it nil when
+// the object is empty
+func decodeAttributes(data []byte, attrs *domain.MessageAttributes) error {
+	var stored map[string]storedAttribute
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return fmt.Errorf("failed to decode message attributes: %v", err)
+	}
+	if len(stored) == 0 {
+		*attrs = nil
+		return nil
+	}
+	*attrs = make(domain.MessageAttributes, len(stored))
+	for name, attr := range stored {
+		(*attrs)[name] = domain.MessageAttribute(attr)
+	}
+	return nil
+}
+
+// execer is implemented by both *sql.DB and *sql.Tx
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+// hasDeduplication reports whether any of messages carries a deduplication id
+func hasDeduplication(messages []*domain.Message) bool {
+	for _, message := range messages {
+		if message.Deduplication != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// deduplicationCandidates returns the first message of each deduplication id
+// of messages, sorted by id. Concurrent batches reserving their ids in this
+// order lock the reservation rows in the same order, so they cannot deadlock.
+func deduplicationCandidates(messages []*domain.Message) []*domain.Message {
+	candidates := make([]*domain.Message, 0, len(messages))
+	seen := make(map[string]bool, len(messages))
+	for _, message := range messages {
+		if message.Deduplication == nil || seen[message.Deduplication.ID] {
+			continue
+		}
+		seen[message.Deduplication.ID] = true
+		candidates = append(candidates, message)
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].Deduplication.ID < candidates[j].Deduplication.ID
+	})
+	return candidates
+}
+
+// heldDeduplicationIDs returns the deduplication ids of candidates that were
+// not reserved for them, because an earlier send still holds them
+func heldDeduplicationIDs(candidates []*domain.Message, holders map[string]string) []string {
+	held := make([]string, 0)
+	for _, message := range candidates {
+		if _, ok := holders[message.Deduplication.ID]; !ok {
+			held = append(held, message.Deduplication.ID)
+		}
+	}
+	return held
+}
+
+// queryHolders adds the deduplication ids and message ids returned by query
+// to holders
+func queryHolders(ctx context.Context, tx *sql.Tx, holders map[string]string, query string, args ...any) error {
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("failed to deduplicate messages: %v", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var deduplicationID, messageID string
+		if err := rows.Scan(&deduplicationID, &messageID); err != nil {
+			return fmt.Errorf("failed to deduplicate messages: %v", err)
+		}
+		holders[deduplicationID] = messageID
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to deduplicate messages: %v", err)
+	}
+	return nil
+}
+
+// applyDeduplication returns the messages to insert. The others repeat a
+// deduplication id held by another message and take its ID instead.
+func applyDeduplication(messages []*domain.Message, holders map[string]string) []*domain.Message {
+	fresh := make([]*domain.Message, 0, len(messages))
+	for _, message := range messages {
+		if message.Deduplication != nil {
+			if holder := holders[message.Deduplication.ID]; holder != message.ID {
+				message.ID = holder
+				continue
+			}
+		}
+		fresh = append(fresh, message)
+	}
+	return fresh
+}
+
+// scanReceiptHandles reads and closes the receipt handles returned by a DELETE
+func scanReceiptHandles(rows *sql.Rows) ([]string, error) {
+	defer rows.Close()
+
+	handles := make([]string, 0)
+	for rows.Next() {
+		var handle string
+		if err := rows.Scan(&handle); err != nil {
+			return nil, fmt.Errorf("failed to delete messages: %v", err)
+		}
+		handles = append(handles, handle)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to delete messages: %v", err)
+	}
+	return handles, nil
+}
+
+// scanBlobKeys reads and closes the blob keys returned by ReferencedBlobKeys
+func scanBlobKeys(rows *sql.Rows) ([]string, error) {
+	defer rows.Close()
+
+	keys := make([]string, 0)
+	for rows.Next() {
+		var key string
+		if err := rows.Scan(&key); err != nil {
+			return nil, fmt.Errorf("failed to look up blob keys: %v", err)
+		}
+		keys = append(keys, key)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to look up blob keys: %v", err)
+	}
+	return keys, nil
+}
+
+// valuesPlaceholders builds the VALUES list of a multi-row INSERT, numbering
+// the placeholders of rows rows of columns columns after prefix ($ or ?)
+func valuesPlaceholders(prefix string, rows int, columns int) string {
+	var b strings.Builder
+	for row := 0; row < rows; row++ {
+		if row > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString("(")
+		for column := 0; column < columns; column++ {
+			if column > 0 {
+				b.WriteString(", ")
+			}
+			fmt.Fprintf(&b, "%s%d", prefix, row*columns+column+1)
+		}
+		b.WriteString(")")
+	}
+	return b.String()
+}
+
+// nullTime stores the zero time as NULL
+func nullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t, Valid: !t.IsZero()}
+}
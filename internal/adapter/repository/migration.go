@@ -0,0 +1,302 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"queueserver/internal/adapter/config"
+)
+
+//go:embed migrations
+var migrationFiles embed.FS
+
+// migrationLockID is the Postgres advisory lock key held while migrating, so
+// replicas starting together apply each migration once
+const migrationLockID = 7264501
+
+// downPreconditions guard the rollback of the migrations, by version, that
+// would lose data earlier versions cannot read. They run in the transaction of
+// the down script and refuse the rollback with an error saying what to do.
+var downPreconditions = map[int]func(ctx context.Context, tx *sql.Tx) error{
+	11: requireUncompressedMessages,
+}
+
+// Migration is one versioned schema change with its up and down scripts
+type Migration struct {
+	Version int
+	Name    string
+	Up      string
+	Down    string
+}
+
+// MigrationStatus reports whether a migration has been applied and when
+type MigrationStatus struct {
+	Migration
+	AppliedAt *time.Time
+}
+
+// Migrator applies the SQL migrations embedded for a backend and records them
+// in the schema_migrations table
+type Migrator struct {
+	db         *sql.DB
+	backend    string
+	migrations []Migration
+}
+
+// NewMigrator opens the database of the backend selected in cfg. The memory
+// backend has no schema, so its migrator has nothing to apply.
+func NewMigrator(cfg *config.Config) (*Migrator, error) {
+	var db *sql.DB
+	var err error
+
+	switch cfg.Backend {
+	case config.BackendPostgres:
+		db, err = openPostgres(cfg.ConString)
+	case config.BackendSQLite:
+		db, err = openSQLite(cfg.SQLitePath)
+	case config.BackendMemory:
+		return &Migrator{backend: cfg.Backend}, nil
+	default:
+		return nil, fmt.Errorf("unknown storage backend %q", cfg.Backend)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	migrations, err := loadMigrations(cfg.Backend)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Migrator{db: db, backend: cfg.Backend, migrations: migrations}, nil
+}
+
+// Up applies every pending migration in version order and returns the applied ones
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	applied := make([]Migration, 0)
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		versions, err := m.appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		for _, migration := range m.migrations {
+			if _, ok := versions[migration.Version]; ok {
+				continue
+			}
+			if err := m.apply(ctx, conn, migration, true); err != nil {
+				return err
+			}
+			applied = append(applied, migration)
+		}
+		return nil
+	})
+	return applied, err
+}
+
+// Down rolls back the last steps applied migrations, newest first, and
+// returns the rolled back ones
+func (m *Migrator) Down(ctx context.Context, steps int) ([]Migration, error) {
+	reverted := make([]Migration, 0)
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		versions, err := m.appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		for i := len(m.migrations) - 1; i >= 0 && len(reverted) < steps; i-- {
+			migration := m.migrations[i]
+			if _, ok := versions[migration.Version]; !ok {
+				continue
+			}
+			if err := m.apply(ctx, conn, migration, false); err != nil {
+				return err
+			}
+			reverted = append(reverted, migration)
+		}
+		return nil
+	})
+	return reverted, err
+}
+
+// Status lists every known migration with the time it was applied, if any
+func (m *Migrator) Status(ctx context.Context) ([]MigrationStatus, error) {
+	statuses := make([]MigrationStatus, 0, len(m.migrations))
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		versions, err := m.appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		for _, migration := range m.migrations {
+			status := MigrationStatus{Migration: migration}
+			if appliedAt, ok := versions[migration.Version]; ok {
+				status.AppliedAt = &appliedAt
+			}
+			statuses = append(statuses, status)
+		}
+		return nil
+	})
+	return statuses, err
+}
+
+func (m *Migrator) Close() error {
+	if m.db == nil {
+		return nil
+	}
+	return m.db.Close()
+}
+
+// withLock runs fn on a dedicated connection after making sure the version
+// table exists, holding the advisory lock on Postgres
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *sql.Conn) error) error {
+	if m.db == nil {
+		return nil
+	}
+
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get database connection: %v", err)
+	}
+	defer conn.Close()
+
+	if m.backend == config.BackendPostgres {
+		if _, err := conn.ExecContext(ctx, `SELECT pg_advisory_lock($1)`, migrationLockID); err != nil {
+			return fmt.Errorf("failed to lock migrations: %v", err)
+		}
+		defer conn.ExecContext(context.Background(), `SELECT pg_advisory_unlock($1)`, migrationLockID)
+	}
+
+	query := `CREATE TABLE IF NOT EXISTS schema_migrations (
+                  version INTEGER PRIMARY KEY,
+                  name TEXT NOT NULL,
+                  applied_at BIGINT NOT NULL
+              )`
+	if _, err := conn.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to create schema_migrations table: %v", err)
+	}
+
+	return fn(conn)
+}
+
+// appliedVersions returns the applied migration versions and their apply time
+func (m *Migrator) appliedVersions(ctx context.Context, conn *sql.Conn) (map[int]time.Time, error) {
+	rows, err := conn.QueryContext(ctx, `SELECT version, applied_at FROM schema_migrations`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read schema_migrations: %v", err)
+	}
+	defer rows.Close()
+
+	versions := make(map[int]time.Time)
+	for rows.Next() {
+		var version int
+		var appliedAt int64
+		if err := rows.Scan(&version, &appliedAt); err != nil {
+			return nil, fmt.Errorf("failed to read schema_migrations: %v", err)
+		}
+		versions[version] = fromMillis(appliedAt)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read schema_migrations: %v", err)
+	}
+	return versions, nil
+}
+
+// apply runs the up or down script of a migration and updates
+// schema_migrations in the same transaction
+func (m *Migrator) apply(ctx context.Context, conn *sql.Conn, migration Migration, up bool) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin migration %d: %v", migration.Version, err)
+	}
+	defer tx.Rollback()
+
+	script, record, args := migration.Down, `DELETE FROM schema_migrations WHERE version = $1`, []any{migration.Version}
+	if up {
+		script = migration.Up
+		record = `INSERT INTO schema_migrations (version, name, applied_at) VALUES ($1, $2, $3)`
+		args = []any{migration.Version, migration.Name, toMillis(time.Now())}
+	}
+
+	if precondition, ok := downPreconditions[migration.Version]; ok && !up {
+		if err := precondition(ctx, tx); err != nil {
+			return fmt.Errorf("cannot roll back migration %d_%s: %v", migration.Version, migration.Name, err)
+		}
+	}
+	if _, err := tx.ExecContext(ctx, script); err != nil {
+		return fmt.Errorf("failed to run migration %d_%s: %v", migration.Version, migration.Name, err)
+	}
+	if _, err := tx.ExecContext(ctx, record, args...); err != nil {
+		return fmt.Errorf("failed to record migration %d_%s: %v", migration.Version, migration.Name, err)
+	}
+
+	return tx.Commit()
+}
+
+// requireUncompressedMessages refuses to drop the compression columns while
+// messages stored compressed remain, as earlier versions cannot read them
+func requireUncompressedMessages(ctx context.Context, tx *sql.Tx) error {
+	var remaining int64
+	query := `SELECT COUNT(*) FROM messages WHERE body_compression <> ''`
+	if err := tx.QueryRowContext(ctx, query).Scan(&remaining); err != nil {
+		return fmt.Errorf("failed to count compressed messages: %v", err)
+	}
+	if remaining > 0 {
+		return fmt.Errorf("%d messages with compressed bodies remain, turn off the compression of every queue and consume them before downgrading", remaining)
+	}
+	return nil
+}
+
+// loadMigrations reads the embedded <version>_<name>.{up,down}.sql files of a
+// backend, sorted by version
+func loadMigrations(backend string) ([]Migration, error) {
+	dir := path.Join("migrations", backend)
+	entries, err := fs.ReadDir(migrationFiles, dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migrations: %v", err)
+	}
+
+	byVersion := make(map[int]*Migration)
+	for _, entry := range entries {
+		fileName := entry.Name()
+		base, direction, ok := strings.Cut(strings.TrimSuffix(fileName, ".sql"), ".")
+		versionText, name, found := strings.Cut(base, "_")
+		version, err := strconv.Atoi(versionText)
+		if !ok || !found || err != nil || (direction != "up" && direction != "down") {
+			return nil, fmt.Errorf("invalid migration file name %q", fileName)
+		}
+
+		content, err := fs.ReadFile(migrationFiles, path.Join(dir, fileName))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration %q: %v", fileName, err)
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: name}
+			byVersion[version] = migration
+		}
+		if direction == "up" {
+			migration.Up = string(content)
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		migrations = append(migrations, *migration)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+	return migrations, nil
+}
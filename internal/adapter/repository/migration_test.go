@@ -0,0 +1,182 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"queueserver/internal/core/domain"
+)
+
+// newTestMigrator opens a migrator on a fresh SQLite database
+func newTestMigrator(t *testing.T) *Migrator {
+	t.Helper()
+	migrator, err := NewMigrator(newSQLiteTestConfig(t))
+	if err != nil {
+		t.Fatalf("NewMigrator() error = %v", err)
+	}
+	t.Cleanup(func() { migrator.Close() })
+	return migrator
+}
+
+// appliedCount returns how many migrations Status reports as applied
+func appliedCount(t *testing.T, migrator *Migrator) int {
+	t.Helper()
+	statuses, err := migrator.Status(context.Background())
+	if err != nil {
+		t.Fatalf("Status() error = %v", err)
+	}
+	if len(statuses) != len(migrator.migrations) {
+		t.Fatalf("Status() listed %d migrations, want %d", len(statuses), len(migrator.migrations))
+	}
+
+	count := 0
+	for _, status := range statuses {
+		if status.AppliedAt != nil {
+			count++
+		}
+	}
+	return count
+}
+
+func TestMigratorRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	migrator := newTestMigrator(t)
+	total := len(migrator.migrations)
+
+	if got := appliedCount(t, migrator); got != 0 {
+		t.Fatalf("applied before Up = %d, want 0", got)
+	}
+
+	applied, err := migrator.Up(ctx)
+	if err != nil {
+		t.Fatalf("Up() error = %v", err)
+	}
+	if len(applied) != total || appliedCount(t, migrator) != total {
+		t.Fatalf("Up() applied %d migrations, want %d", len(applied), total)
+	}
+
+	// Nothing is left to apply
+	if applied, err := migrator.Up(ctx); err != nil || len(applied) != 0 {
+		t.Fatalf("second Up() = %d migrations, %v, want none", len(applied), err)
+	}
+
+	reverted, err := migrator.Down(ctx, total)
+	if err != nil {
+		t.Fatalf("Down() error = %v", err)
+	}
+	if len(reverted) != total || reverted[0].Version != migrator.migrations[total-1].Version {
+		t.Fatalf("Down() reverted %v, want every migration newest first", reverted)
+	}
+	if got := appliedCount(t, migrator); got != 0 {
+		t.Fatalf("applied after Down = %d, want 0", got)
+	}
+
+	if applied, err := migrator.Up(ctx); err != nil || len(applied) != total {
+		t.Fatalf("Up() after Down = %d migrations, %v, want %d", len(applied), err, total)
+	}
+}
+
+func TestMigratorRefusesExistingTable(t *testing.T) {
+	ctx := context.Background()
+	migrator := newTestMigrator(t)
+
+	if _, err := migrator.db.ExecContext(ctx, `CREATE TABLE queues (name TEXT PRIMARY KEY)`); err != nil {
+		t.Fatalf("failed to create table: %v", err)
+	}
+
+	_, err := migrator.Up(ctx)
+	if err == nil || !strings.Contains(err.Error(), "queues") {
+		t.Fatalf("Up() error = %v, want the existing queues table refused", err)
+	}
+	if got := appliedCount(t, migrator); got != 0 {
+		t.Fatalf("applied = %d, want nothing recorded", got)
+	}
+}
+
+func TestMigratorSequenceBackfill(t *testing.T) {
+	ctx := context.Background()
+	migrator := newTestMigrator(t)
+	if _, err := migrator.Up(ctx); err != nil {
+		t.Fatalf("Up() error = %v", err)
+	}
+
+	// Roll back to the schema before sequence numbers and store messages there
+	steps := 0
+	for _, migration := range migrator.migrations {
+		if migration.Version >= 3 {
+			steps++
+		}
+	}
+	if _, err := migrator.Down(ctx, steps); err != nil {
+		t.Fatalf("Down() error = %v", err)
+	}
+	for _, message := range []struct {
+		id     string
+		sentAt int64
+	}{{"c", 2000}, {"b", 1000}, {"a", 2000}} {
+		query := `INSERT INTO messages (id, body, receipt_handle, visibility_timeout, queue_name, sent_at)
+                  VALUES (?, '', '', 0, 'queue', ?)`
+		if _, err := migrator.db.ExecContext(ctx, query, message.id, message.sentAt); err != nil {
+			t.Fatalf("failed to insert message: %v", err)
+		}
+	}
+
+	if _, err := migrator.Up(ctx); err != nil {
+		t.Fatalf("Up() error = %v", err)
+	}
+
+	// Existing messages are numbered in send order, ties broken by id
+	rows, err := migrator.db.QueryContext(ctx, `SELECT id, sequence_number FROM messages ORDER BY sequence_number`)
+	if err != nil {
+		t.Fatalf("failed to read messages: %v", err)
+	}
+	defer rows.Close()
+	got := make([]string, 0)
+	for rows.Next() {
+		var id string
+		var sequenceNumber int64
+		if err := rows.Scan(&id, &sequenceNumber); err != nil {
+			t.Fatalf("failed to read messages: %v", err)
+		}
+		got = append(got, fmt.Sprintf("%s=%d", id, sequenceNumber))
+	}
+	if want := "[b=1 a=2 c=3]"; fmt.Sprint(got) != want {
+		t.Errorf("sequence numbers = %v, want %s", got, want)
+	}
+}
+
+func TestMigratorRefusesCompressedDowngrade(t *testing.T) {
+	ctx := context.Background()
+	migrator := newTestMigrator(t)
+	if _, err := migrator.Up(ctx); err != nil {
+		t.Fatalf("Up() error = %v", err)
+	}
+	total := appliedCount(t, migrator)
+
+	query := `INSERT INTO messages (id, body, receipt_handle, visibility_timeout, queue_name, sent_at, body_compression)
+              VALUES ('compressed', '', '', 0, 'queue', 0, ?)`
+	if _, err := migrator.db.ExecContext(ctx, query, domain.CompressionZstd); err != nil {
+		t.Fatalf("failed to insert message: %v", err)
+	}
+
+	// The compression migration is the newest, rolled back first
+	_, err := migrator.Down(ctx, 1)
+	if err == nil || !strings.Contains(err.Error(), "1 messages with compressed bodies remain") {
+		t.Fatalf("Down() error = %v, want the compressed messages refused", err)
+	}
+	if got := appliedCount(t, migrator); got != total {
+		t.Fatalf("applied after refused Down() = %d, want %d", got, total)
+	}
+
+	if _, err := migrator.db.ExecContext(ctx, `DELETE FROM messages`); err != nil {
+		t.Fatalf("failed to delete messages: %v", err)
+	}
+	if _, err := migrator.Down(ctx, 1); err != nil {
+		t.Fatalf("Down() error = %v", err)
+	}
+	if got := appliedCount(t, migrator); got != total-1 {
+		t.Fatalf("applied after Down() = %d, want %d", got, total-1)
+	}
+}
@@ -0,0 +1,62 @@
+-- Deployments that predate the migrations created these tables by hand. They
+-- are upgraded in place when they have the columns the server always wrote,
+-- and refused otherwise instead of being half adopted.
+DO $$
+DECLARE
+    missing TEXT;
+BEGIN
+    SELECT string_agg(required.table_name || '.' || required.column_name, ', ')
+    INTO missing
+    FROM (VALUES
+        ('queues', 'name'),
+        ('queues', 'created_at'),
+        ('messages', 'id'),
+        ('messages', 'body'),
+        ('messages', 'receipt_handle'),
+        ('messages', 'visibility_timeout'),
+        ('messages', 'queue_name')
+    ) AS required (table_name, column_name)
+    JOIN information_schema.tables existing
+        ON existing.table_schema = current_schema() AND existing.table_name = required.table_name
+    WHERE NOT EXISTS (
+        SELECT 1 FROM information_schema.columns
+        WHERE columns.table_schema = current_schema()
+          AND columns.table_name = required.table_name
+          AND columns.column_name = required.column_name
+    );
+
+    IF missing IS NOT NULL THEN
+        RAISE EXCEPTION 'existing tables lack the columns %, rename or drop them before migrating', missing;
+    END IF;
+END
+$$;
+
+CREATE TABLE IF NOT EXISTS queues (
+    name TEXT PRIMARY KEY,
+    created_at TIMESTAMPTZ NOT NULL,
+    visibility_timeout INTEGER NOT NULL DEFAULT 30,
+    message_retention_period INTEGER NOT NULL DEFAULT 345600,
+    maximum_message_size INTEGER NOT NULL DEFAULT 262144,
+    delay_seconds INTEGER NOT NULL DEFAULT 0,
+    receive_wait_time INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE TABLE IF NOT EXISTS messages (
+    id TEXT PRIMARY KEY,
+    body TEXT NOT NULL,
+    receipt_handle TEXT NOT NULL,
+    visibility_timeout TIMESTAMPTZ NOT NULL,
+    queue_name TEXT NOT NULL,
+    sent_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- The columns the hand-made tables lack
+ALTER TABLE queues ADD COLUMN IF NOT EXISTS visibility_timeout INTEGER NOT NULL DEFAULT 30;
+ALTER TABLE queues ADD COLUMN IF NOT EXISTS message_retention_period INTEGER NOT NULL DEFAULT 345600;
+ALTER TABLE queues ADD COLUMN IF NOT EXISTS maximum_message_size INTEGER NOT NULL DEFAULT 262144;
+ALTER TABLE queues ADD COLUMN IF NOT EXISTS delay_seconds INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE queues ADD COLUMN IF NOT EXISTS receive_wait_time INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS sent_at TIMESTAMPTZ NOT NULL DEFAULT now();
+
+CREATE INDEX IF NOT EXISTS messages_queue_visibility_idx ON messages (queue_name, visibility_timeout);
+CREATE INDEX IF NOT EXISTS messages_receipt_handle_idx ON messages (receipt_handle);
@@ -0,0 +1,6 @@
+ALTER TABLE messages DROP COLUMN source_queue_name;
+ALTER TABLE messages DROP COLUMN first_received_at;
+ALTER TABLE messages DROP COLUMN receive_count;
+
+ALTER TABLE queues DROP COLUMN redrive_max_receive_count;
+ALTER TABLE queues DROP COLUMN redrive_dead_letter_queue;
@@ -0,0 +1,6 @@
+ALTER TABLE queues ADD COLUMN redrive_dead_letter_queue TEXT NOT NULL DEFAULT '';
+ALTER TABLE queues ADD COLUMN redrive_max_receive_count INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE messages ADD COLUMN receive_count INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE messages ADD COLUMN first_received_at TIMESTAMPTZ;
+ALTER TABLE messages ADD COLUMN source_queue_name TEXT NOT NULL DEFAULT '';
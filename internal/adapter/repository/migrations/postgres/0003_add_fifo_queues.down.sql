@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS messages_queue_group_idx;
+
+ALTER TABLE messages DROP COLUMN message_group_id;
+ALTER TABLE messages DROP COLUMN sequence_number;
+DROP SEQUENCE IF EXISTS messages_sequence_number_seq;
+
+ALTER TABLE queues DROP COLUMN fifo_queue;
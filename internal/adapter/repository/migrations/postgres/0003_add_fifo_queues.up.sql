@@ -0,0 +1,19 @@
+ALTER TABLE queues ADD COLUMN fifo_queue BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Messages are delivered in the order of their sequence number
+CREATE SEQUENCE IF NOT EXISTS messages_sequence_number_seq;
+ALTER TABLE messages ADD COLUMN sequence_number BIGINT;
+ALTER SEQUENCE messages_sequence_number_seq OWNED BY messages.sequence_number;
+
+-- Stored messages are numbered in the order they were sent, not in the
+-- physical order of the rows
+UPDATE messages SET sequence_number = numbered.sequence_number
+FROM (SELECT id, row_number() OVER (ORDER BY sent_at, id) AS sequence_number FROM messages) AS numbered
+WHERE messages.id = numbered.id;
+SELECT setval('messages_sequence_number_seq', COALESCE(MAX(sequence_number), 0) + 1, false) FROM messages;
+
+ALTER TABLE messages ALTER COLUMN sequence_number SET DEFAULT nextval('messages_sequence_number_seq');
+ALTER TABLE messages ALTER COLUMN sequence_number SET NOT NULL;
+ALTER TABLE messages ADD COLUMN message_group_id TEXT NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS messages_queue_group_idx ON messages (queue_name, message_group_id, sequence_number);
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS message_deduplication;
+
+ALTER TABLE queues DROP COLUMN deduplication_window;
+ALTER TABLE queues DROP COLUMN content_based_deduplication;
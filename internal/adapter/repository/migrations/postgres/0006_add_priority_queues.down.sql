@@ -0,0 +1,4 @@
+ALTER TABLE messages DROP COLUMN priority;
+
+ALTER TABLE queues DROP COLUMN priority_aging;
+ALTER TABLE queues DROP COLUMN priority_queue;
@@ -0,0 +1 @@
+ALTER TABLE messages DROP COLUMN message_attributes;
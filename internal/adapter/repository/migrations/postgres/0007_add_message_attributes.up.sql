@@ -0,0 +1,2 @@
+-- User-defined attributes of each message, a JSON object keyed by name
+ALTER TABLE messages ADD COLUMN message_attributes JSONB NOT NULL DEFAULT '{}';
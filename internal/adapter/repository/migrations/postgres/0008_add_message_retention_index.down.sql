@@ -0,0 +1 @@
+DROP INDEX IF EXISTS messages_queue_sent_at_idx;
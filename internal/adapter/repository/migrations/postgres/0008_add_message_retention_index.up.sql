@@ -0,0 +1,2 @@
+-- Lets the retention reaper find the expired messages of a queue
+CREATE INDEX IF NOT EXISTS messages_queue_sent_at_idx ON messages (queue_name, sent_at);
@@ -0,0 +1,8 @@
+-- Fails on bodies that are not valid UTF-8
+ALTER TABLE schedules DROP COLUMN content_encoding;
+ALTER TABLE schedules DROP COLUMN content_type;
+ALTER TABLE schedules ALTER COLUMN body TYPE TEXT USING convert_from(body, 'UTF8');
+
+ALTER TABLE messages DROP COLUMN content_encoding;
+ALTER TABLE messages DROP COLUMN content_type;
+ALTER TABLE messages ALTER COLUMN body TYPE TEXT USING convert_from(body, 'UTF8');
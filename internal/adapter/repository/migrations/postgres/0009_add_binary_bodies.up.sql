@@ -0,0 +1,9 @@
+-- Bodies are arbitrary bytes, described by the content type and encoding the
+-- producer sent them with
+ALTER TABLE messages ALTER COLUMN body TYPE BYTEA USING convert_to(body, 'UTF8');
+ALTER TABLE messages ADD COLUMN content_type TEXT NOT NULL DEFAULT '';
+ALTER TABLE messages ADD COLUMN content_encoding TEXT NOT NULL DEFAULT '';
+
+ALTER TABLE schedules ALTER COLUMN body TYPE BYTEA USING convert_to(body, 'UTF8');
+ALTER TABLE schedules ADD COLUMN content_type TEXT NOT NULL DEFAULT '';
+ALTER TABLE schedules ADD COLUMN content_encoding TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,2 @@
+-- Key of the body in the blob store when it was offloaded, empty otherwise
+ALTER TABLE messages ADD COLUMN blob_key TEXT NOT NULL DEFAULT '';
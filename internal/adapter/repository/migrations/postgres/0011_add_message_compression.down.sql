@@ -0,0 +1,5 @@
+-- Earlier versions cannot read compressed bodies, the migrator refuses this
+-- downgrade while messages stored compressed remain
+ALTER TABLE messages DROP COLUMN body_compression;
+
+ALTER TABLE queues DROP COLUMN compression;
@@ -0,0 +1,24 @@
+-- Timestamps are stored as Unix milliseconds so they compare numerically.
+-- No server ever created these tables before the migrations, so a database
+-- that already has them is refused.
+CREATE TABLE queues (
+    name TEXT PRIMARY KEY,
+    created_at INTEGER NOT NULL,
+    visibility_timeout INTEGER NOT NULL DEFAULT 30,
+    message_retention_period INTEGER NOT NULL DEFAULT 345600,
+    maximum_message_size INTEGER NOT NULL DEFAULT 262144,
+    delay_seconds INTEGER NOT NULL DEFAULT 0,
+    receive_wait_time INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE TABLE messages (
+    id TEXT PRIMARY KEY,
+    body TEXT NOT NULL,
+    receipt_handle TEXT NOT NULL,
+    visibility_timeout INTEGER NOT NULL,
+    queue_name TEXT NOT NULL,
+    sent_at INTEGER NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS messages_queue_visibility_idx ON messages (queue_name, visibility_timeout);
+CREATE INDEX IF NOT EXISTS messages_receipt_handle_idx ON messages (receipt_handle);
@@ -0,0 +1,8 @@
+DROP TRIGGER IF EXISTS messages_sequence_number;
+DROP INDEX IF EXISTS messages_queue_group_idx;
+DROP INDEX IF EXISTS messages_sequence_number_idx;
+
+ALTER TABLE messages DROP COLUMN message_group_id;
+ALTER TABLE messages DROP COLUMN sequence_number;
+
+ALTER TABLE queues DROP COLUMN fifo_queue;
@@ -0,0 +1,22 @@
+ALTER TABLE queues ADD COLUMN fifo_queue INTEGER NOT NULL DEFAULT 0;
+
+-- Messages are delivered in the order of their sequence number. New messages
+-- are numbered after every stored one, which is safe as SQLite serialises
+-- writers.
+ALTER TABLE messages ADD COLUMN sequence_number INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE messages ADD COLUMN message_group_id TEXT NOT NULL DEFAULT '';
+
+-- Stored messages are numbered in the order they were sent
+UPDATE messages SET sequence_number = numbered.sequence_number
+FROM (SELECT id, row_number() OVER (ORDER BY sent_at, id) AS sequence_number FROM messages) AS numbered
+WHERE messages.id = numbered.id;
+
+CREATE INDEX IF NOT EXISTS messages_sequence_number_idx ON messages (sequence_number);
+CREATE INDEX IF NOT EXISTS messages_queue_group_idx ON messages (queue_name, message_group_id, sequence_number);
+
+CREATE TRIGGER IF NOT EXISTS messages_sequence_number AFTER INSERT ON messages
+WHEN NEW.sequence_number = 0
+BEGIN
+    UPDATE messages SET sequence_number = (SELECT MAX(sequence_number) FROM messages) + 1
+    WHERE id = NEW.id;
+END;
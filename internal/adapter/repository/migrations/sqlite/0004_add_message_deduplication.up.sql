@@ -0,0 +1,14 @@
+ALTER TABLE queues ADD COLUMN content_based_deduplication INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE queues ADD COLUMN deduplication_window INTEGER NOT NULL DEFAULT 300;
+
+-- Deduplication ids reserved by recent sends. A row keeps rejecting repeats
+-- until it expires, then the next send with the same id takes it over.
+CREATE TABLE IF NOT EXISTS message_deduplication (
+    queue_name TEXT NOT NULL,
+    deduplication_id TEXT NOT NULL,
+    message_id TEXT NOT NULL,
+    expires_at INTEGER NOT NULL,
+    PRIMARY KEY (queue_name, deduplication_id)
+);
+
+CREATE INDEX IF NOT EXISTS message_deduplication_expires_at_idx ON message_deduplication (queue_name, expires_at);
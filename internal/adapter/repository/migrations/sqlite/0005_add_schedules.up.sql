@@ -0,0 +1,15 @@
+-- Messages to enqueue at a fixed time, or on a cron expression when
+-- cron_expression is set
+CREATE TABLE IF NOT EXISTS schedules (
+    id TEXT PRIMARY KEY,
+    queue_name TEXT NOT NULL,
+    body TEXT NOT NULL,
+    message_group_id TEXT NOT NULL DEFAULT '',
+    cron_expression TEXT NOT NULL DEFAULT '',
+    next_fire_at INTEGER NOT NULL,
+    fire_count INTEGER NOT NULL DEFAULT 0,
+    created_at INTEGER NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS schedules_next_fire_at_idx ON schedules (next_fire_at);
+CREATE INDEX IF NOT EXISTS schedules_queue_name_idx ON schedules (queue_name, next_fire_at);
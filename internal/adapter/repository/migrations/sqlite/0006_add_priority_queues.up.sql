@@ -0,0 +1,4 @@
+ALTER TABLE queues ADD COLUMN priority_queue INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE queues ADD COLUMN priority_aging INTEGER NOT NULL DEFAULT 60;
+
+ALTER TABLE messages ADD COLUMN priority INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,5 @@
+ALTER TABLE schedules DROP COLUMN content_encoding;
+ALTER TABLE schedules DROP COLUMN content_type;
+
+ALTER TABLE messages DROP COLUMN content_encoding;
+ALTER TABLE messages DROP COLUMN content_type;
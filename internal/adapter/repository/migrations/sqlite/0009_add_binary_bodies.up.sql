@@ -0,0 +1,8 @@
+-- Bodies are arbitrary bytes, described by the content type and encoding the
+-- producer sent them with. The body columns keep their TEXT declaration:
+-- SQLite stores the new bodies as BLOBs and reads the old ones back as bytes.
+ALTER TABLE messages ADD COLUMN content_type TEXT NOT NULL DEFAULT '';
+ALTER TABLE messages ADD COLUMN content_encoding TEXT NOT NULL DEFAULT '';
+
+ALTER TABLE schedules ADD COLUMN content_type TEXT NOT NULL DEFAULT '';
+ALTER TABLE schedules ADD COLUMN content_encoding TEXT NOT NULL DEFAULT '';
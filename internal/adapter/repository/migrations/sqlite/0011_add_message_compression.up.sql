@@ -0,0 +1,6 @@
+-- Codec the bodies of new messages of the queue are compressed with, empty
+-- when they are stored as sent
+ALTER TABLE queues ADD COLUMN compression TEXT NOT NULL DEFAULT '';
+
+-- Codec the stored body of each message is compressed with
+ALTER TABLE messages ADD COLUMN body_compression TEXT NOT NULL DEFAULT '';
@@ -8,45 +8,83 @@ import (
 
 	"queueserver/internal/adapter/config"
 	"queueserver/internal/core/domain"
-
-	_ "github.com/lib/pq"
 )
 
+const queueColumns = `name, created_at, visibility_timeout, message_retention_period,
+              maximum_message_size, delay_seconds, receive_wait_time,
+              redrive_dead_letter_queue, redrive_max_receive_count, fifo_queue,
+              content_based_deduplication, deduplication_window, priority_queue, priority_aging, compression`
+
 type PostgresQueueRepository struct {
 	db *sql.DB
 }
 
 func NewPostgresQueueRepository(config *config.Config) (*PostgresQueueRepository, error) {
-	db, err := sql.Open("postgres", config.ConString)
+	db, err := openPostgres(config.ConString)
 	if err != nil {
-		return nil, fmt.Errorf("failed to connect to database: %v", err)
-	}
-
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-
-	if err := db.PingContext(ctx); err != nil {
-		return nil, fmt.Errorf("failed to ping database: %v", err)
+		return nil, err
 	}
 
 	return &PostgresQueueRepository{db: db}, nil
 }
 
 func (r *PostgresQueueRepository) Save(ctx context.Context, queue *domain.Queue) error {
-	query := `INSERT INTO queues (name, created_at) VALUES ($1, $2) RETURNING name`
-	err := r.db.QueryRowContext(ctx, query, queue.Name, time.Now()).Scan(&queue.Name)
+	query := `INSERT INTO queues (` + queueColumns + `)
+              VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15) RETURNING name`
+	if queue.CreatedAt.IsZero() {
+		queue.CreatedAt = time.Now()
+	}
+	deadLetterQueue, maxReceiveCount := redriveColumns(queue.RedrivePolicy)
+	err := r.db.QueryRowContext(ctx, query, queue.Name, queue.CreatedAt,
+		seconds(queue.VisibilityTimeout), seconds(queue.MessageRetentionPeriod),
+		queue.MaximumMessageSize, seconds(queue.Delay), seconds(queue.ReceiveWaitTime),
+		deadLetterQueue, maxReceiveCount, queue.FifoQueue,
+		queue.ContentBasedDeduplication, seconds(queue.DeduplicationWindow),
+		queue.PriorityQueue, seconds(queue.PriorityAging), queue.Compression).Scan(&queue.Name)
 	if err != nil {
 		return fmt.Errorf("failed to save queue: %v", err)
 	}
 	return nil
 }
 
+func (r *PostgresQueueRepository) Update(ctx context.Context, queue *domain.Queue) error {
+	query := `UPDATE queues SET visibility_timeout = $2, message_retention_period = $3,
+                  maximum_message_size = $4, delay_seconds = $5, receive_wait_time = $6,
+                  redrive_dead_letter_queue = $7, redrive_max_receive_count = $8,
+                  content_based_deduplication = $9, deduplication_window = $10,
+                  priority_queue = $11, priority_aging = $12, compression = $13
+              WHERE name = $1`
+	deadLetterQueue, maxReceiveCount := redriveColumns(queue.RedrivePolicy)
+	result, err := r.db.ExecContext(ctx, query, queue.Name,
+		seconds(queue.VisibilityTimeout), seconds(queue.MessageRetentionPeriod),
+		queue.MaximumMessageSize, seconds(queue.Delay), seconds(queue.ReceiveWaitTime),
+		deadLetterQueue, maxReceiveCount,
+		queue.ContentBasedDeduplication, seconds(queue.DeduplicationWindow),
+		queue.PriorityQueue, seconds(queue.PriorityAging), queue.Compression)
+	if err != nil {
+		return fmt.Errorf("failed to update queue: %v", err)
+	}
+	return updatedQueue(result)
+}
+
+// updatedQueue returns domain.ErrQueueNotFound when an UPDATE matched no queue
+func updatedQueue(result sql.Result) error {
+	updated, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to update queue: %v", err)
+	}
+	if updated == 0 {
+		return domain.ErrQueueNotFound
+	}
+	return nil
+}
+
 func (r *PostgresQueueRepository) GetByName(ctx context.Context, name string) (*domain.Queue, error) {
-	query := `SELECT name, created_at FROM queues WHERE name = $1`
+	query := `SELECT ` + queueColumns + ` FROM queues WHERE name = $1`
 	row := r.db.QueryRowContext(ctx, query, name)
 
-	queue := &domain.Queue{}
-	if err := row.Scan(&queue.Name, &queue.CreatedAt); err != nil {
+	queue, err := scanQueue(row)
+	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
 		}
@@ -55,6 +93,30 @@ func (r *PostgresQueueRepository) GetByName(ctx context.Context, name string) (*
 	return queue, nil
 }
 
+func (r *PostgresQueueRepository) List(ctx context.Context, prefix string, startAfter string, limit int) ([]*domain.Queue, error) {
+	query := `SELECT ` + queueColumns + ` FROM queues
+              WHERE substr(name, 1, length($1)) = $1 AND name > $2
+              ORDER BY name LIMIT $3`
+	rows, err := r.db.QueryContext(ctx, query, prefix, startAfter, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list queues: %v", err)
+	}
+	defer rows.Close()
+
+	queues := make([]*domain.Queue, 0)
+	for rows.Next() {
+		queue, err := scanQueue(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list queues: %v", err)
+		}
+		queues = append(queues, queue)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list queues: %v", err)
+	}
+	return queues, nil
+}
+
 func (r *PostgresQueueRepository) Delete(ctx context.Context, name string) error {
 	query := `DELETE FROM queues WHERE name = $1`
 	_, err := r.db.ExecContext(ctx, query, name)
@@ -63,3 +125,50 @@ func (r *PostgresQueueRepository) Delete(ctx context.Context, name string) error
 	}
 	return nil
 }
+
+// rowScanner is implemented by both *sql.Row and *sql.Rows
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanQueue(row rowScanner) (*domain.Queue, error) {
+	var visibilityTimeout, retentionPeriod, delay, receiveWaitTime, deduplicationWindow, priorityAging int64
+	var deadLetterQueue string
+	var maxReceiveCount int
+	queue := &domain.Queue{}
+	if err := row.Scan(&queue.Name, &queue.CreatedAt, &visibilityTimeout, &retentionPeriod,
+		&queue.MaximumMessageSize, &delay, &receiveWaitTime, &deadLetterQueue, &maxReceiveCount, &queue.FifoQueue,
+		&queue.ContentBasedDeduplication, &deduplicationWindow, &queue.PriorityQueue, &priorityAging,
+		&queue.Compression); err != nil {
+		return nil, err
+	}
+	queue.VisibilityTimeout = time.Duration(visibilityTimeout) * time.Second
+	queue.MessageRetentionPeriod = time.Duration(retentionPeriod) * time.Second
+	queue.Delay = time.Duration(delay) * time.Second
+	queue.ReceiveWaitTime = time.Duration(receiveWaitTime) * time.Second
+	queue.RedrivePolicy = redrivePolicy(deadLetterQueue, maxReceiveCount)
+	queue.DeduplicationWindow = time.Duration(deduplicationWindow) * time.Second
+	queue.PriorityAging = time.Duration(priorityAging) * time.Second
+	return queue, nil
+}
+
+// redriveColumns flattens a redrive policy into its stored columns, an empty
+// dead-letter queue name meaning no policy
+func redriveColumns(policy *domain.RedrivePolicy) (string, int) {
+	if policy == nil {
+		return "", 0
+	}
+	return policy.DeadLetterQueueName, policy.MaxReceiveCount
+}
+
+func redrivePolicy(deadLetterQueue string, maxReceiveCount int) *domain.RedrivePolicy {
+	if deadLetterQueue == "" {
+		return nil
+	}
+	return &domain.RedrivePolicy{DeadLetterQueueName: deadLetterQueue, MaxReceiveCount: maxReceiveCount}
+}
+
+// seconds converts a duration to the whole seconds stored in the database
+func seconds(d time.Duration) int64 {
+	return int64(d / time.Second)
+}
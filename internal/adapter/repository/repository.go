@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"fmt"
+
+	"queueserver/internal/adapter/config"
+	port "queueserver/internal/core/port/repository"
+)
+
+var (
+	_ port.QueueRepository    = (*PostgresQueueRepository)(nil)
+	_ port.MessageRepository  = (*PostgresMessageRepository)(nil)
+	_ port.ScheduleRepository = (*PostgresScheduleRepository)(nil)
+	_ port.QueueRepository    = (*MemoryQueueRepository)(nil)
+	_ port.MessageRepository  = (*MemoryMessageRepository)(nil)
+	_ port.ScheduleRepository = (*MemoryScheduleRepository)(nil)
+	_ port.QueueRepository    = (*SQLiteQueueRepository)(nil)
+	_ port.MessageRepository  = (*SQLiteMessageRepository)(nil)
+	_ port.ScheduleRepository = (*SQLiteScheduleRepository)(nil)
+	_ port.BlobStore          = (*FilesystemBlobStore)(nil)
+)
+
+// Repositories groups the repositories of one storage backend
+type Repositories struct {
+	Queues    port.QueueRepository
+	Messages  port.MessageRepository
+	Schedules port.ScheduleRepository
+}
+
+// NewRepositories creates the repositories of the storage backend selected in cfg
+func NewRepositories(cfg *config.Config) (*Repositories, error) {
+	switch cfg.Backend {
+	case config.BackendPostgres:
+		messageRepo, err := NewPostgresMessageRepository(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("error to create a Message Repository: %v", err)
+		}
+		queueRepo, err := NewPostgresQueueRepository(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("error to create a Queue Repository: %v", err)
+		}
+		scheduleRepo, err := NewPostgresScheduleRepository(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("error to create a Schedule Repository: %v", err)
+		}
+		return &Repositories{Queues: queueRepo, Messages: messageRepo, Schedules: scheduleRepo}, nil
+	case config.BackendSQLite:
+		messageRepo, err := NewSQLiteMessageRepository(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("error to create a Message Repository: %v", err)
+		}
+		queueRepo, err := NewSQLiteQueueRepository(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("error to create a Queue Repository: %v", err)
+		}
+		scheduleRepo, err := NewSQLiteScheduleRepository(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("error to create a Schedule Repository: %v", err)
+		}
+		return &Repositories{Queues: queueRepo, Messages: messageRepo, Schedules: scheduleRepo}, nil
+	case config.BackendMemory:
+		queueRepo := NewMemoryQueueRepository()
+		messageRepo := NewMemoryMessageRepository(queueRepo)
+		return &Repositories{
+			Queues:    queueRepo,
+			Messages:  messageRepo,
+			Schedules: NewMemoryScheduleRepository(messageRepo),
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown storage backend %q", cfg.Backend)
+	}
+}
@@ -0,0 +1,155 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"queueserver/internal/adapter/config"
+	"queueserver/internal/core/domain"
+)
+
+const scheduleColumns = `id, queue_name, body, message_group_id, cron_expression,
+              next_fire_at, fire_count, created_at, content_type, content_encoding`
+
+type PostgresScheduleRepository struct {
+	db *sql.DB
+}
+
+func NewPostgresScheduleRepository(config *config.Config) (*PostgresScheduleRepository, error) {
+	db, err := openPostgres(config.ConString)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PostgresScheduleRepository{db: db}, nil
+}
+
+func (r *PostgresScheduleRepository) Save(ctx context.Context, schedule *domain.Schedule) error {
+	query := `INSERT INTO schedules (` + scheduleColumns + `) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`
+	_, err := r.db.ExecContext(ctx, query, schedule.ID, schedule.QueueName, schedule.Body, schedule.MessageGroupID,
+		schedule.CronExpression, schedule.NextFireAt, schedule.FireCount, schedule.CreatedAt,
+		schedule.ContentType, schedule.ContentEncoding)
+	if err != nil {
+		return fmt.Errorf("failed to save schedule: %v", err)
+	}
+	return nil
+}
+
+func (r *PostgresScheduleRepository) GetByID(ctx context.Context, id string) (*domain.Schedule, error) {
+	query := `SELECT ` + scheduleColumns + ` FROM schedules WHERE id = $1`
+	schedule, err := scanSchedule(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get schedule: %v", err)
+	}
+	return schedule, nil
+}
+
+func (r *PostgresScheduleRepository) ListByQueue(ctx context.Context, queueName string) ([]*domain.Schedule, error) {
+	query := `SELECT ` + scheduleColumns + ` FROM schedules WHERE queue_name = $1 ORDER BY next_fire_at, id`
+	rows, err := r.db.QueryContext(ctx, query, queueName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list schedules: %v", err)
+	}
+	return scanSchedules(rows, scanSchedule)
+}
+
+func (r *PostgresScheduleRepository) Due(ctx context.Context, now time.Time, limit int) ([]*domain.Schedule, error) {
+	query := `SELECT ` + scheduleColumns + ` FROM schedules WHERE next_fire_at <= $1 ORDER BY next_fire_at, id LIMIT $2`
+	rows, err := r.db.QueryContext(ctx, query, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list due schedules: %v", err)
+	}
+	return scanSchedules(rows, scanSchedule)
+}
+
+// Fire advances or deletes the schedule and inserts its message in one
+// transaction. The fire count check makes concurrent fires of the same
+// schedule by several replicas enqueue a single message.
+func (r *PostgresScheduleRepository) Fire(ctx context.Context, schedule *domain.Schedule, message *domain.Message, last bool) (bool, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to fire schedule: %v", err)
+	}
+	defer tx.Rollback()
+
+	var result sql.Result
+	if last {
+		query := `DELETE FROM schedules WHERE id = $1 AND fire_count = $2`
+		result, err = tx.ExecContext(ctx, query, schedule.ID, schedule.FireCount-1)
+	} else {
+		query := `UPDATE schedules SET next_fire_at = $3, fire_count = $4 WHERE id = $1 AND fire_count = $2`
+		result, err = tx.ExecContext(ctx, query, schedule.ID, schedule.FireCount-1, schedule.NextFireAt, schedule.FireCount)
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to fire schedule: %v", err)
+	}
+	fired, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to fire schedule: %v", err)
+	}
+	if fired == 0 {
+		return false, nil
+	}
+
+	if err := insertMessages(ctx, tx, []*domain.Message{message}); err != nil {
+		return false, err
+	}
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to fire schedule: %v", err)
+	}
+	return true, nil
+}
+
+func (r *PostgresScheduleRepository) Delete(ctx context.Context, id string) (bool, error) {
+	query := `DELETE FROM schedules WHERE id = $1`
+	result, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete schedule: %v", err)
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to delete schedule: %v", err)
+	}
+	return deleted > 0, nil
+}
+
+func (r *PostgresScheduleRepository) DeleteByQueueName(ctx context.Context, queueName string) error {
+	query := `DELETE FROM schedules WHERE queue_name = $1`
+	if _, err := r.db.ExecContext(ctx, query, queueName); err != nil {
+		return fmt.Errorf("failed to delete queue schedules: %v", err)
+	}
+	return nil
+}
+
+func scanSchedule(row rowScanner) (*domain.Schedule, error) {
+	schedule := &domain.Schedule{}
+	if err := row.Scan(&schedule.ID, &schedule.QueueName, &schedule.Body, &schedule.MessageGroupID,
+		&schedule.CronExpression, &schedule.NextFireAt, &schedule.FireCount, &schedule.CreatedAt,
+		&schedule.ContentType, &schedule.ContentEncoding); err != nil {
+		return nil, err
+	}
+	return schedule, nil
+}
+
+// scanSchedules reads and closes the rows of a schedule query
+func scanSchedules(rows *sql.Rows, scan func(rowScanner) (*domain.Schedule, error)) ([]*domain.Schedule, error) {
+	defer rows.Close()
+
+	schedules := make([]*domain.Schedule, 0)
+	for rows.Next() {
+		schedule, err := scan(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list schedules: %v", err)
+		}
+		schedules = append(schedules, schedule)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list schedules: %v", err)
+	}
+	return schedules, nil
+}
@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"queueserver/internal/core/domain"
+	port "queueserver/internal/core/port/repository"
+)
+
+func TestScheduleFireOnce(t *testing.T) {
+	backends := []struct {
+		name string
+		open func(t *testing.T) (port.ScheduleRepository, port.MessageRepository)
+	}{
+		{
+			name: "memory",
+			open: func(t *testing.T) (port.ScheduleRepository, port.MessageRepository) {
+				messageRepo := NewMemoryMessageRepository(NewMemoryQueueRepository())
+				return NewMemoryScheduleRepository(messageRepo), messageRepo
+			},
+		},
+		{
+			name: "sqlite",
+			open: func(t *testing.T) (port.ScheduleRepository, port.MessageRepository) {
+				cfg := newMigratedSQLiteTestConfig(t)
+				_, messageRepo := openSQLiteTestRepositories(t, cfg, "queue")
+				scheduleRepo, err := NewSQLiteScheduleRepository(cfg)
+				if err != nil {
+					t.Fatalf("NewSQLiteScheduleRepository() error = %v", err)
+				}
+				t.Cleanup(func() { scheduleRepo.db.Close() })
+				return scheduleRepo, messageRepo
+			},
+		},
+	}
+	for _, backend := range backends {
+		t.Run(backend.name, func(t *testing.T) {
+			ctx := context.Background()
+			scheduleRepo, messageRepo := backend.open(t)
+			now := time.UnixMilli(time.Now().UnixMilli())
+			schedule := &domain.Schedule{
+				ID: "schedule", QueueName: "queue", Body: []byte("tick"), CronExpression: "* * * * *",
+				NextFireAt: now, CreatedAt: now,
+			}
+			if err := scheduleRepo.Save(ctx, schedule); err != nil {
+				t.Fatalf("Save() error = %v", err)
+			}
+
+			// Replicas that loaded the same due schedule race to fire it
+			const replicas = 5
+			var wg sync.WaitGroup
+			fired := make(chan bool, replicas)
+			for i := 0; i < replicas; i++ {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					loaded := *schedule
+					if _, err := loaded.Advance(now); err != nil {
+						t.Errorf("Advance() error = %v", err)
+						return
+					}
+					message := &domain.Message{ID: string(rune('a' + i)), QueueName: "queue", Body: []byte("tick"), VisibilityTimeout: now, SentAt: now}
+					ok, err := scheduleRepo.Fire(ctx, &loaded, message, false)
+					if err != nil {
+						t.Errorf("Fire() error = %v", err)
+					}
+					fired <- ok
+				}(i)
+			}
+			wg.Wait()
+			close(fired)
+
+			count := 0
+			for ok := range fired {
+				if ok {
+					count++
+				}
+			}
+			if count != 1 {
+				t.Fatalf("%d fires succeeded, want exactly 1", count)
+			}
+
+			stored, err := scheduleRepo.GetByID(ctx, schedule.ID)
+			if err != nil {
+				t.Fatalf("GetByID() error = %v", err)
+			}
+			if stored == nil || stored.FireCount != 1 || !stored.NextFireAt.Equal(now.Add(time.Minute).Truncate(time.Minute)) {
+				t.Errorf("stored schedule = %+v, want it fired once and due at the next minute", stored)
+			}
+			stats, err := messageRepo.CountByQueue(ctx, "queue", now)
+			if err != nil {
+				t.Fatalf("CountByQueue() error = %v", err)
+			}
+			if stats.Visible != 1 {
+				t.Errorf("the fires enqueued %d messages, want 1", stats.Visible)
+			}
+		})
+	}
+}
@@ -0,0 +1,485 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"queueserver/internal/adapter/config"
+	"queueserver/internal/core/domain"
+)
+
+type SQLiteMessageRepository struct {
+	db *sql.DB
+}
+
+func NewSQLiteMessageRepository(config *config.Config) (*SQLiteMessageRepository, error) {
+	db, err := openSQLite(config.SQLitePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SQLiteMessageRepository{db: db}, nil
+}
+
+func (r *SQLiteMessageRepository) Save(ctx context.Context, message *domain.Message) error {
+	query := `INSERT INTO messages (` + messageInsertColumns + `)
+              VALUES ` + valuesPlaceholders("?", 1, messageInsertColumnCount) + ` ON CONFLICT (id) DO UPDATE
+              SET body = excluded.body, receipt_handle = excluded.receipt_handle,
+                  visibility_timeout = excluded.visibility_timeout, queue_name = excluded.queue_name,
+                  receive_count = excluded.receive_count, first_received_at = excluded.first_received_at,
+                  source_queue_name = excluded.source_queue_name, message_group_id = excluded.message_group_id,
+                  priority = excluded.priority, message_attributes = excluded.message_attributes,
+                  content_type = excluded.content_type, content_encoding = excluded.content_encoding,
+                  blob_key = excluded.blob_key, body_compression = excluded.body_compression`
+	values, err := sqliteMessageValues(message)
+	if err != nil {
+		return err
+	}
+	_, err = r.db.ExecContext(ctx, query, values...)
+	if err != nil {
+		return fmt.Errorf("failed to save message: %v", err)
+	}
+	return nil
+}
+
+// SaveBatch inserts new messages with a single multi-row INSERT statement.
+// When some carry a deduplication id, their ids are first reserved in the
+// same transaction, which SQLite serialises with every other writer.
+func (r *SQLiteMessageRepository) SaveBatch(ctx context.Context, messages []*domain.Message) error {
+	if !hasDeduplication(messages) {
+		return insertSQLiteMessages(ctx, r.db, messages)
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to save messages: %v", err)
+	}
+	defer tx.Rollback()
+
+	holders, err := r.deduplicate(ctx, tx, messages)
+	if err != nil {
+		return err
+	}
+	if err := insertSQLiteMessages(ctx, tx, applyDeduplication(messages, holders)); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to save messages: %v", err)
+	}
+	return nil
+}
+
+// deduplicate reserves the deduplication ids of messages with a single
+// upsert, which also takes over the expired ids. It returns the ID of the
+// message holding each id: the message of the batch that reserved it, or the
+// original message of an earlier send.
+func (r *SQLiteMessageRepository) deduplicate(ctx context.Context, tx *sql.Tx, messages []*domain.Message) (map[string]string, error) {
+	candidates := deduplicationCandidates(messages)
+	args := make([]any, 0, len(candidates)*4+1)
+	for _, message := range candidates {
+		args = append(args, message.QueueName, message.Deduplication.ID, message.ID, toMillis(message.Deduplication.ExpiresAt))
+	}
+	args = append(args, toMillis(messages[0].SentAt))
+
+	query := `INSERT INTO message_deduplication (queue_name, deduplication_id, message_id, expires_at)
+              VALUES ` + valuesPlaceholders("?", len(candidates), 4) + `
+              ON CONFLICT (queue_name, deduplication_id) DO UPDATE
+              SET message_id = excluded.message_id, expires_at = excluded.expires_at
+              WHERE message_deduplication.expires_at <= ?` + strconv.Itoa(len(args)) + `
+              RETURNING deduplication_id, message_id`
+	holders := make(map[string]string, len(candidates))
+	if err := queryHolders(ctx, tx, holders, query, args...); err != nil {
+		return nil, err
+	}
+
+	held := heldDeduplicationIDs(candidates, holders)
+	if len(held) == 0 {
+		return holders, nil
+	}
+	query = `SELECT deduplication_id, message_id FROM message_deduplication
+              WHERE queue_name = ? AND deduplication_id IN (` + strings.TrimSuffix(strings.Repeat("?, ", len(held)), ", ") + `)`
+	args = []any{messages[0].QueueName}
+	for _, id := range held {
+		args = append(args, id)
+	}
+	if err := queryHolders(ctx, tx, holders, query, args...); err != nil {
+		return nil, err
+	}
+	if len(heldDeduplicationIDs(candidates, holders)) > 0 {
+		// The queue was deleted meanwhile
+		return nil, fmt.Errorf("%w: the reservation of an earlier send disappeared", domain.ErrDeduplicationConflict)
+	}
+	return holders, nil
+}
+
+// insertSQLiteMessages adds messages with a single multi-row INSERT statement
+func insertSQLiteMessages(ctx context.Context, db execer, messages []*domain.Message) error {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	args := make([]any, 0, len(messages)*messageInsertColumnCount)
+	for _, message := range messages {
+		values, err := sqliteMessageValues(message)
+		if err != nil {
+			return err
+		}
+		args = append(args, values...)
+	}
+
+	// The insert trigger numbers the rows in the order of the VALUES list
+	query := `INSERT INTO messages (` + messageInsertColumns + `) VALUES ` +
+		valuesPlaceholders("?", len(messages), messageInsertColumnCount)
+	if _, err := db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to save messages: %v", err)
+	}
+	return nil
+}
+
+func (r *SQLiteMessageRepository) GetByMessageID(ctx context.Context, id string) (*domain.Message, error) {
+	query := `SELECT ` + messageColumns + ` FROM messages WHERE id = ?`
+	return r.queryMessage(ctx, query, id)
+}
+
+func (r *SQLiteMessageRepository) GetByReceiptHandle(ctx context.Context, receiptHandle string) (*domain.Message, error) {
+	query := `SELECT ` + messageColumns + ` FROM messages WHERE receipt_handle = ?`
+	return r.queryMessage(ctx, query, receiptHandle)
+}
+
+// Claim delivers up to len(req.ReceiptHandles) of the oldest visible messages
+// of the queue, first moving visible messages that exhausted the redrive
+// policy to the dead-letter queue in the same transaction. On FIFO queues only
+// the oldest message of each message group is claimed. SQLite serialises
+// writers, so the single claiming UPDATE statement is enough to keep two
+// callers from claiming the same message.
+func (r *SQLiteMessageRepository) Claim(ctx context.Context, req domain.ClaimRequest) ([]*domain.Message, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim message: %v", err)
+	}
+	defer tx.Rollback()
+
+	maxReceiveCount := 0
+	if req.DeadLetterQueue != nil {
+		maxReceiveCount = req.Queue.RedrivePolicy.MaxReceiveCount
+
+		query := `UPDATE messages SET queue_name = ?2, visibility_timeout = ?3,
+                      source_queue_name = CASE WHEN source_queue_name = '' THEN queue_name ELSE source_queue_name END
+                  WHERE queue_name = ?1 AND visibility_timeout <= ?3 AND receive_count >= ?4`
+		if _, err := tx.ExecContext(ctx, query, req.Queue.Name, req.DeadLetterQueue.Name, toMillis(req.Now), maxReceiveCount); err != nil {
+			return nil, fmt.Errorf("failed to move messages to the dead-letter queue: %v", err)
+		}
+	}
+
+	// Priority queues order by the priority key, the others by sequence number
+	var priorityAging int64
+	if req.Queue.PriorityQueue {
+		priorityAging = req.Queue.PriorityAging.Milliseconds()
+	}
+
+	// The claimed messages are numbered in delivery order, and each takes the
+	// receipt handle at its position in the JSON array ?2
+	query := `WITH claimed AS (
+                  SELECT m.id AS claimed_id, row_number() OVER (
+                      ORDER BY CASE WHEN ?7 > 0 THEN m.sent_at - m.priority * ?7 END, m.sequence_number
+                  ) AS position
+                  FROM messages m
+                  WHERE m.queue_name = ?1 AND m.visibility_timeout <= ?3 AND (?5 = 0 OR m.receive_count < ?5)
+                      AND (NOT ?6 OR NOT EXISTS (
+                          SELECT 1 FROM messages earlier
+                          WHERE earlier.queue_name = m.queue_name AND earlier.message_group_id = m.message_group_id
+                              AND earlier.sequence_number < m.sequence_number
+                      ))
+                  ORDER BY position
+                  LIMIT ?8
+              )
+              UPDATE messages SET receipt_handle = json_extract(?2, '$[' || (claimed.position - 1) || ']'),
+                  visibility_timeout = ?4, receive_count = receive_count + 1, first_received_at = COALESCE(first_received_at, ?3)
+              FROM claimed
+              WHERE messages.id = claimed.claimed_id
+              RETURNING ` + messageColumns
+	receiptHandles, err := json.Marshal(req.ReceiptHandles)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim message: %v", err)
+	}
+	rows, err := tx.QueryContext(ctx, query, req.Queue.Name, string(receiptHandles), toMillis(req.Now), toMillis(req.VisibleAt),
+		maxReceiveCount, req.Queue.FifoQueue, priorityAging, len(req.ReceiptHandles))
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim message: %v", err)
+	}
+	defer rows.Close()
+
+	messages := make([]*domain.Message, 0, len(req.ReceiptHandles))
+	for rows.Next() {
+		message, err := scanSQLiteMessage(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to claim message: %v", err)
+		}
+		messages = append(messages, message)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to claim message: %v", err)
+	}
+	sortClaimed(messages, req.ReceiptHandles)
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to claim message: %v", err)
+	}
+	return messages, nil
+}
+
+// MoveNext redrives the oldest visible message of sourceQueueName. Messages
+// whose destination is not an existing queue are skipped: without a
+// destination, those with no source queue or whose source queue was deleted.
+// A moved message is numbered after the messages already in its new queue.
+func (r *SQLiteMessageRepository) MoveNext(ctx context.Context, sourceQueueName string, destinationQueueName string, now time.Time) (*domain.Message, error) {
+	query := `UPDATE messages SET queue_name = CASE WHEN ?2 = '' THEN source_queue_name ELSE ?2 END,
+                  source_queue_name = '', visibility_timeout = ?3, receive_count = 0, first_received_at = NULL,
+                  sequence_number = (SELECT MAX(sequence_number) FROM messages) + 1
+              WHERE id = (
+                  SELECT id FROM messages
+                  WHERE queue_name = ?1 AND visibility_timeout <= ?3 AND EXISTS (
+                      SELECT 1 FROM queues
+                      WHERE queues.name = CASE WHEN ?2 = '' THEN messages.source_queue_name ELSE ?2 END
+                  )
+                  ORDER BY sequence_number
+                  LIMIT 1
+              )
+              RETURNING ` + messageColumns
+	row := r.db.QueryRowContext(ctx, query, sourceQueueName, destinationQueueName, toMillis(now))
+
+	message, err := scanSQLiteMessage(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to move message: %v", err)
+	}
+	return message, nil
+}
+
+func (r *SQLiteMessageRepository) CountMovable(ctx context.Context, sourceQueueName string, destinationQueueName string, now time.Time) (int64, error) {
+	query := `SELECT COUNT(*) FROM messages
+              WHERE queue_name = ?1 AND visibility_timeout <= ?3 AND EXISTS (
+                  SELECT 1 FROM queues
+                  WHERE queues.name = CASE WHEN ?2 = '' THEN messages.source_queue_name ELSE ?2 END
+              )`
+
+	var count int64
+	if err := r.db.QueryRowContext(ctx, query, sourceQueueName, destinationQueueName, toMillis(now)).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count messages to move: %v", err)
+	}
+	return count, nil
+}
+
+func (r *SQLiteMessageRepository) NextVisibleAt(ctx context.Context, queueName string, now time.Time) (time.Time, error) {
+	query := `SELECT MIN(visibility_timeout) FROM messages WHERE queue_name = ? AND visibility_timeout > ?`
+
+	var next sql.NullInt64
+	if err := r.db.QueryRowContext(ctx, query, queueName, toMillis(now)).Scan(&next); err != nil {
+		return time.Time{}, fmt.Errorf("failed to get the next visibility timeout: %v", err)
+	}
+	if !next.Valid {
+		return time.Time{}, nil
+	}
+	return fromMillis(next.Int64), nil
+}
+
+func (r *SQLiteMessageRepository) CountByQueue(ctx context.Context, queueName string, now time.Time) (domain.QueueStats, error) {
+	query := `SELECT COUNT(*) FILTER (WHERE visibility_timeout <= ?2),
+                     COUNT(*) FILTER (WHERE visibility_timeout > ?2 AND receive_count > 0),
+                     COUNT(*) FILTER (WHERE visibility_timeout > ?2 AND receive_count = 0)
+              FROM messages WHERE queue_name = ?1`
+
+	stats := domain.QueueStats{}
+	err := r.db.QueryRowContext(ctx, query, queueName, toMillis(now)).Scan(&stats.Visible, &stats.NotVisible, &stats.Delayed)
+	if err != nil {
+		return stats, fmt.Errorf("failed to count messages: %v", err)
+	}
+	return stats, nil
+}
+
+func (r *SQLiteMessageRepository) ChangeVisibility(ctx context.Context, queueName string, receiptHandle string, now time.Time, visibleAt time.Time) (bool, error) {
+	query := `UPDATE messages SET visibility_timeout = ?4
+              WHERE queue_name = ?1 AND receipt_handle = ?2 AND receive_count > 0 AND visibility_timeout > ?3`
+	result, err := r.db.ExecContext(ctx, query, queueName, receiptHandle, toMillis(now), toMillis(visibleAt))
+	if err != nil {
+		return false, fmt.Errorf("failed to change message visibility: %v", err)
+	}
+	changed, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to change message visibility: %v", err)
+	}
+	return changed > 0, nil
+}
+
+func (r *SQLiteMessageRepository) Delete(ctx context.Context, id string) error {
+	query := `DELETE FROM messages WHERE id = ?`
+	_, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete message: %v", err)
+	}
+	return nil
+}
+
+func (r *SQLiteMessageRepository) DeleteByReceiptHandle(ctx context.Context, queueName string, receiptHandle string) (bool, error) {
+	query := `DELETE FROM messages WHERE queue_name = ? AND receipt_handle = ?`
+	result, err := r.db.ExecContext(ctx, query, queueName, receiptHandle)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete message: %v", err)
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to delete message: %v", err)
+	}
+	return deleted > 0, nil
+}
+
+func (r *SQLiteMessageRepository) DeleteByReceiptHandles(ctx context.Context, queueName string, receiptHandles []string) ([]string, error) {
+	if len(receiptHandles) == 0 {
+		return []string{}, nil
+	}
+
+	args := make([]any, 0, len(receiptHandles)+1)
+	args = append(args, queueName)
+	for _, handle := range receiptHandles {
+		args = append(args, handle)
+	}
+
+	query := `DELETE FROM messages WHERE queue_name = ? AND receipt_handle IN (?` +
+		strings.Repeat(", ?", len(receiptHandles)-1) + `) RETURNING receipt_handle`
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete messages: %v", err)
+	}
+	return scanReceiptHandles(rows)
+}
+
+// DeleteExpired deletes the oldest messages of queueName sent before sentBefore
+func (r *SQLiteMessageRepository) DeleteExpired(ctx context.Context, queueName string, sentBefore time.Time, limit int) ([]*domain.Message, error) {
+	query := `DELETE FROM messages WHERE id IN (
+                  SELECT id FROM messages WHERE queue_name = ?1 AND sent_at < ?2
+                  ORDER BY sequence_number LIMIT ?3
+              )
+              RETURNING ` + messageColumns
+	rows, err := r.db.QueryContext(ctx, query, queueName, toMillis(sentBefore), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete expired messages: %v", err)
+	}
+	defer rows.Close()
+
+	messages := make([]*domain.Message, 0)
+	for rows.Next() {
+		message, err := scanSQLiteMessage(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to delete expired messages: %v", err)
+		}
+		messages = append(messages, message)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to delete expired messages: %v", err)
+	}
+	return messages, nil
+}
+
+func (r *SQLiteMessageRepository) PurgeDeduplication(ctx context.Context, now time.Time) error {
+	query := `DELETE FROM message_deduplication WHERE expires_at <= ?`
+	if _, err := r.db.ExecContext(ctx, query, toMillis(now)); err != nil {
+		return fmt.Errorf("failed to purge deduplication ids: %v", err)
+	}
+	return nil
+}
+
+func (r *SQLiteMessageRepository) DeleteByQueueName(ctx context.Context, queueName string) error {
+	query := `DELETE FROM messages WHERE queue_name = ?`
+	_, err := r.db.ExecContext(ctx, query, queueName)
+	if err != nil {
+		return fmt.Errorf("failed to delete queue messages: %v", err)
+	}
+
+	query = `DELETE FROM message_deduplication WHERE queue_name = ?`
+	if _, err := r.db.ExecContext(ctx, query, queueName); err != nil {
+		return fmt.Errorf("failed to delete queue deduplication ids: %v", err)
+	}
+	return nil
+}
+
+// ReferencedBlobKeys looks keys up by primary key, as bodies are offloaded
+// under the ID of their message
+func (r *SQLiteMessageRepository) ReferencedBlobKeys(ctx context.Context, keys []string) ([]string, error) {
+	if len(keys) == 0 {
+		return []string{}, nil
+	}
+
+	args := make([]any, 0, len(keys))
+	for _, key := range keys {
+		args = append(args, key)
+	}
+
+	query := `SELECT blob_key FROM messages WHERE id IN (?` +
+		strings.Repeat(", ?", len(keys)-1) + `) AND blob_key = id`
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up blob keys: %v", err)
+	}
+	return scanBlobKeys(rows)
+}
+
+func (r *SQLiteMessageRepository) queryMessage(ctx context.Context, query string, args ...any) (*domain.Message, error) {
+	row := r.db.QueryRowContext(ctx, query, args...)
+
+	message, err := scanSQLiteMessage(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get message: %v", err)
+	}
+	return message, nil
+}
+
+// sqliteMessageValues returns the values of messageInsertColumns for message,
+// with its timestamps in milliseconds and its body compressed with the codec
+// of the message
+func sqliteMessageValues(message *domain.Message) ([]any, error) {
+	body, compression, err := compressMessageBody(message)
+	if err != nil {
+		return nil, err
+	}
+	return []any{message.ID, body, message.ReceiptHandle,
+		toMillis(message.VisibilityTimeout), message.QueueName, toMillis(message.SentAt),
+		message.ReceiveCount, nullMillis(message.FirstReceivedAt), message.SourceQueueName, message.MessageGroupID, message.Priority,
+		encodeAttributes(message.Attributes), message.ContentType, message.ContentEncoding, message.BlobKey, compression}, nil
+}
+
+// scanSQLiteMessage reads a message row, converting its millisecond timestamps
+func scanSQLiteMessage(row rowScanner) (*domain.Message, error) {
+	var visibilityTimeout, sentAt int64
+	var firstReceivedAt sql.NullInt64
+	var attributes []byte
+	message := &domain.Message{}
+	err := row.Scan(&message.ID, &message.Body, &message.ReceiptHandle, &visibilityTimeout,
+		&message.QueueName, &sentAt, &message.ReceiveCount, &firstReceivedAt, &message.SourceQueueName,
+		&message.MessageGroupID, &message.Priority, &attributes, &message.ContentType, &message.ContentEncoding,
+		&message.BlobKey, &message.Compression, &message.SequenceNumber)
+	if err != nil {
+		return nil, err
+	}
+	message.VisibilityTimeout = fromMillis(visibilityTimeout)
+	message.SentAt = fromMillis(sentAt)
+	if firstReceivedAt.Valid {
+		message.FirstReceivedAt = fromMillis(firstReceivedAt.Int64)
+	}
+	if err := decompressMessageBody(message); err != nil {
+		return nil, err
+	}
+	if err := decodeAttributes(attributes, &message.Attributes); err != nil {
+		return nil, err
+	}
+	return message, nil
+}
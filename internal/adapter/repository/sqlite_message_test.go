@@ -0,0 +1,421 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"queueserver/internal/adapter/config"
+	"queueserver/internal/core/domain"
+)
+
+// newSQLiteTestConfig returns the config of a fresh SQLite database
+func newSQLiteTestConfig(t *testing.T) *config.Config {
+	t.Helper()
+	return &config.Config{Backend: config.BackendSQLite, SQLitePath: filepath.Join(t.TempDir(), "queue.db")}
+}
+
+// newMigratedSQLiteTestConfig returns the config of a fresh SQLite database
+// with every migration applied
+func newMigratedSQLiteTestConfig(t *testing.T) *config.Config {
+	t.Helper()
+	cfg := newSQLiteTestConfig(t)
+
+	migrator, err := NewMigrator(cfg)
+	if err != nil {
+		t.Fatalf("NewMigrator() error = %v", err)
+	}
+	defer migrator.Close()
+	if _, err := migrator.Up(context.Background()); err != nil {
+		t.Fatalf("Up() error = %v", err)
+	}
+	return cfg
+}
+
+// newSQLiteTestRepositories opens the SQLite repositories on a fresh, migrated
+// database with the queues named in queueNames
+func newSQLiteTestRepositories(t *testing.T, queueNames ...string) (*SQLiteQueueRepository, *SQLiteMessageRepository) {
+	t.Helper()
+	return openSQLiteTestRepositories(t, newMigratedSQLiteTestConfig(t), queueNames...)
+}
+
+// openSQLiteTestRepositories opens the SQLite repositories on the database of
+// cfg and creates the queues named in queueNames
+func openSQLiteTestRepositories(t *testing.T, cfg *config.Config, queueNames ...string) (*SQLiteQueueRepository, *SQLiteMessageRepository) {
+	t.Helper()
+
+	queueRepo, err := NewSQLiteQueueRepository(cfg)
+	if err != nil {
+		t.Fatalf("NewSQLiteQueueRepository() error = %v", err)
+	}
+	t.Cleanup(func() { queueRepo.db.Close() })
+	messageRepo, err := NewSQLiteMessageRepository(cfg)
+	if err != nil {
+		t.Fatalf("NewSQLiteMessageRepository() error = %v", err)
+	}
+	t.Cleanup(func() { messageRepo.db.Close() })
+
+	for _, name := range queueNames {
+		if err := queueRepo.Save(context.Background(), domain.NewQueue(name)); err != nil {
+			t.Fatalf("Save(%s) error = %v", name, err)
+		}
+	}
+	return queueRepo, messageRepo
+}
+
+func TestSQLiteQueueRepositoryUpdate(t *testing.T) {
+	ctx := context.Background()
+	queueRepo, _ := newSQLiteTestRepositories(t, "queue")
+
+	tests := []struct {
+		name    string
+		queue   string
+		wantErr error
+	}{
+		{name: "existing queue", queue: "queue"},
+		{name: "missing queue", queue: "missing", wantErr: domain.ErrQueueNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			queue := domain.NewQueue(tt.queue)
+			queue.VisibilityTimeout = time.Minute
+			if err := queueRepo.Update(ctx, queue); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Update() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSQLiteClaim(t *testing.T) {
+	now := time.UnixMilli(time.Now().UnixMilli())
+	messages := []*domain.Message{
+		{ID: "hidden", QueueName: "queue", VisibilityTimeout: now.Add(time.Minute), SentAt: now.Add(-3 * time.Second)},
+		{ID: "first", QueueName: "queue", VisibilityTimeout: now, SentAt: now.Add(-2 * time.Second)},
+		{ID: "second", QueueName: "queue", VisibilityTimeout: now, SentAt: now.Add(-time.Second)},
+		{ID: "other", QueueName: "other", VisibilityTimeout: now, SentAt: now.Add(-4 * time.Second)},
+	}
+
+	tests := []struct {
+		name      string
+		queueName string
+		wantIDs   []string // in claim order
+	}{
+		{name: "oldest visible first", queueName: "queue", wantIDs: []string{"first", "second"}},
+		{name: "other queue", queueName: "other", wantIDs: []string{"other"}},
+		{name: "unknown queue", queueName: "missing"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			queueRepo, messageRepo := newSQLiteTestRepositories(t, "queue", "other")
+			for _, message := range messages {
+				stored := *message
+				stored.Body = []byte("body of " + message.ID)
+				if err := messageRepo.Save(ctx, &stored); err != nil {
+					t.Fatalf("Save() error = %v", err)
+				}
+			}
+
+			queue, err := queueRepo.GetByName(ctx, tt.queueName)
+			if err != nil {
+				t.Fatalf("GetByName() error = %v", err)
+			}
+			if queue == nil {
+				queue = domain.NewQueue(tt.queueName)
+			}
+			claim := func(receiptHandle string, now, visibleAt time.Time) (*domain.Message, error) {
+				claimed, err := messageRepo.Claim(ctx, domain.ClaimRequest{
+					Queue: queue, ReceiptHandles: []string{receiptHandle}, Now: now, VisibleAt: visibleAt,
+				})
+				if err != nil || len(claimed) == 0 {
+					return nil, err
+				}
+				return claimed[0], nil
+			}
+
+			visibleAt := now.Add(30 * time.Second)
+			for i, wantID := range tt.wantIDs {
+				receiptHandle := "handle-" + wantID
+				claimed, err := claim(receiptHandle, now, visibleAt)
+				if err != nil {
+					t.Fatalf("Claim() error = %v", err)
+				}
+				if claimed == nil || claimed.ID != wantID {
+					t.Fatalf("claim %d = %v, want %s", i+1, claimed, wantID)
+				}
+				if claimed.ReceiptHandle != receiptHandle || !claimed.VisibilityTimeout.Equal(visibleAt) || string(claimed.Body) != "body of "+wantID {
+					t.Errorf("claimed message = %+v, want it hidden until %s with handle %s", claimed, visibleAt, receiptHandle)
+				}
+			}
+
+			// Claimed messages stay hidden
+			claimed, err := claim("handle", now, visibleAt)
+			if err != nil || claimed != nil {
+				t.Fatalf("Claim() = %v, %v, want nothing left to claim", claimed, err)
+			}
+			// They come back once their visibility timeout expires
+			if len(tt.wantIDs) > 0 {
+				claimed, err := claim("handle", visibleAt, visibleAt.Add(time.Minute))
+				if err != nil || claimed == nil || claimed.ID != tt.wantIDs[0] {
+					t.Fatalf("Claim() after the timeout = %v, %v, want %s", claimed, err, tt.wantIDs[0])
+				}
+			}
+		})
+	}
+}
+
+func TestSQLiteMoveNext(t *testing.T) {
+	now := time.UnixMilli(time.Now().UnixMilli())
+	messages := []*domain.Message{
+		{ID: "from-source", SourceQueueName: "source", SentAt: now.Add(-3 * time.Second)},
+		{ID: "from-deleted", SourceQueueName: "deleted", SentAt: now.Add(-2 * time.Second)},
+		{ID: "hidden", SourceQueueName: "source", VisibilityTimeout: now.Add(time.Minute), SentAt: now.Add(-4 * time.Second)},
+		{ID: "sent", SentAt: now.Add(-time.Second)},
+	}
+
+	tests := []struct {
+		name        string
+		destination string
+		wantIDs     []string // in move order
+		wantQueue   string   // queue of the moved messages
+	}{
+		{name: "back to the source queue", wantIDs: []string{"from-source"}, wantQueue: "source"},
+		{name: "to a destination queue", destination: "other", wantIDs: []string{"from-source", "from-deleted", "sent"}, wantQueue: "other"},
+		{name: "to a missing destination queue", destination: "deleted"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			_, messageRepo := newSQLiteTestRepositories(t, "dlq", "source", "other")
+			for _, message := range messages {
+				stored := *message
+				stored.QueueName = "dlq"
+				stored.Body = []byte(message.ID)
+				stored.ReceiveCount = 3
+				stored.FirstReceivedAt = now.Add(-time.Hour)
+				if stored.VisibilityTimeout.IsZero() {
+					stored.VisibilityTimeout = now
+				}
+				if err := messageRepo.Save(ctx, &stored); err != nil {
+					t.Fatalf("Save() error = %v", err)
+				}
+			}
+
+			for i, wantID := range tt.wantIDs {
+				moved, err := messageRepo.MoveNext(ctx, "dlq", tt.destination, now)
+				if err != nil {
+					t.Fatalf("MoveNext() error = %v", err)
+				}
+				if moved == nil || moved.ID != wantID {
+					t.Fatalf("move %d = %v, want %s", i+1, moved, wantID)
+				}
+				if moved.QueueName != tt.wantQueue || moved.SourceQueueName != "" || moved.ReceiveCount != 0 || !moved.FirstReceivedAt.IsZero() {
+					t.Errorf("moved message = %+v, want it in %s with its receive counters reset", moved, tt.wantQueue)
+				}
+				// Moved messages are numbered after every saved one
+				if moved.SequenceNumber != int64(len(messages)+i+1) {
+					t.Errorf("moved message has sequence number %d, want %d", moved.SequenceNumber, len(messages)+i+1)
+				}
+			}
+			if moved, err := messageRepo.MoveNext(ctx, "dlq", tt.destination, now); err != nil || moved != nil {
+				t.Fatalf("MoveNext() = %v, %v, want nothing left to move", moved, err)
+			}
+		})
+	}
+}
+
+func TestSQLiteClaimBatch(t *testing.T) {
+	ctx := context.Background()
+	now := time.UnixMilli(time.Now().UnixMilli())
+	queueRepo, messageRepo := newSQLiteTestRepositories(t, "queue")
+	ids := []string{"first", "second", "third"}
+	for i, id := range ids {
+		message := &domain.Message{ID: id, QueueName: "queue", Body: []byte(id), VisibilityTimeout: now, SentAt: now.Add(time.Duration(i) * time.Second)}
+		if err := messageRepo.Save(ctx, message); err != nil {
+			t.Fatalf("Save() error = %v", err)
+		}
+	}
+	queue, err := queueRepo.GetByName(ctx, "queue")
+	if err != nil {
+		t.Fatalf("GetByName() error = %v", err)
+	}
+
+	handles := []string{"h1", "h2", "h3", "h4", "h5"}
+	claimed, err := messageRepo.Claim(ctx, domain.ClaimRequest{Queue: queue, ReceiptHandles: handles, Now: now, VisibleAt: now.Add(time.Minute)})
+	if err != nil {
+		t.Fatalf("Claim() error = %v", err)
+	}
+	if len(claimed) != len(ids) {
+		t.Fatalf("Claim() returned %d messages, want %d", len(claimed), len(ids))
+	}
+
+	seen := make(map[string]bool)
+	for i, message := range claimed {
+		if message.ID != ids[i] {
+			t.Errorf("message %d = %s, want %s", i, message.ID, ids[i])
+		}
+		if seen[message.ReceiptHandle] || message.ReceiptHandle == "" || message.ReceiptHandle == "h4" || message.ReceiptHandle == "h5" {
+			t.Errorf("message %s got receipt handle %q, want a distinct one of the first three", message.ID, message.ReceiptHandle)
+		}
+		seen[message.ReceiptHandle] = true
+	}
+}
+
+func TestSQLiteClaimFifo(t *testing.T) {
+	ctx := context.Background()
+	now := time.UnixMilli(time.Now().UnixMilli())
+	queueRepo, messageRepo := newSQLiteTestRepositories(t)
+	queue := domain.NewQueue("fifo")
+	queue.FifoQueue = true
+	if err := queueRepo.Save(ctx, queue); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	for _, id := range []string{"a1", "b1", "a2", "b2", "a3"} {
+		message := &domain.Message{ID: id, QueueName: "fifo", Body: []byte(id), MessageGroupID: id[:1], VisibilityTimeout: now, SentAt: now}
+		if err := messageRepo.Save(ctx, message); err != nil {
+			t.Fatalf("Save() error = %v", err)
+		}
+	}
+
+	// Each claim takes the head of every group, which is deleted before the next
+	handles := []string{"h1", "h2", "h3", "h4", "h5"}
+	for i, want := range []string{"[a1 b1]", "[a2 b2]", "[a3]"} {
+		claimed, err := messageRepo.Claim(ctx, domain.ClaimRequest{Queue: queue, ReceiptHandles: handles, Now: now, VisibleAt: now.Add(time.Minute)})
+		if err != nil {
+			t.Fatalf("Claim() error = %v", err)
+		}
+		ids := make([]string, 0, len(claimed))
+		for _, message := range claimed {
+			ids = append(ids, message.ID)
+		}
+		if got := fmt.Sprint(ids); got != want {
+			t.Fatalf("claim %d = %s, want %s", i+1, got, want)
+		}
+
+		// The groups stay blocked while their heads are in flight
+		again, err := messageRepo.Claim(ctx, domain.ClaimRequest{Queue: queue, ReceiptHandles: handles, Now: now, VisibleAt: now.Add(time.Minute)})
+		if err != nil || len(again) != 0 {
+			t.Fatalf("Claim() with the heads in flight = %d messages, %v, want none", len(again), err)
+		}
+		for _, id := range ids {
+			if err := messageRepo.Delete(ctx, id); err != nil {
+				t.Fatalf("Delete() error = %v", err)
+			}
+		}
+	}
+}
+
+func TestSQLiteDeduplication(t *testing.T) {
+	now := time.UnixMilli(time.Now().UnixMilli())
+	message := func(id string, queueName string, sentAt time.Time) *domain.Message {
+		return &domain.Message{
+			ID: id, QueueName: queueName, Body: []byte(id), VisibilityTimeout: sentAt, SentAt: sentAt,
+			Deduplication: &domain.Deduplication{ID: "order-1", ExpiresAt: sentAt.Add(time.Minute)},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		batches [][]*domain.Message
+		wantIDs []string // of every message once saved
+		stored  int64    // in queue
+	}{
+		{
+			name:    "repeat in a later batch",
+			batches: [][]*domain.Message{{message("m1", "queue", now)}, {message("m2", "queue", now.Add(time.Second))}},
+			wantIDs: []string{"m1", "m1"},
+			stored:  1,
+		},
+		{
+			name:    "repeat in the same batch",
+			batches: [][]*domain.Message{{message("m1", "queue", now), message("m2", "queue", now)}},
+			wantIDs: []string{"m1", "m1"},
+			stored:  1,
+		},
+		{
+			name:    "repeat after expiry",
+			batches: [][]*domain.Message{{message("m1", "queue", now)}, {message("m2", "queue", now.Add(time.Minute))}},
+			wantIDs: []string{"m1", "m2"},
+			stored:  2,
+		},
+		{
+			name:    "same id in another queue",
+			batches: [][]*domain.Message{{message("m1", "queue", now)}, {message("m2", "other", now)}},
+			wantIDs: []string{"m1", "m2"},
+			stored:  1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			_, messageRepo := newSQLiteTestRepositories(t, "queue", "other")
+
+			ids := make([]string, 0)
+			for _, batch := range tt.batches {
+				if err := messageRepo.SaveBatch(ctx, batch); err != nil {
+					t.Fatalf("SaveBatch() error = %v", err)
+				}
+				for _, saved := range batch {
+					ids = append(ids, saved.ID)
+				}
+			}
+			if fmt.Sprint(ids) != fmt.Sprint(tt.wantIDs) {
+				t.Errorf("saved IDs = %v, want %v", ids, tt.wantIDs)
+			}
+
+			stats, err := messageRepo.CountByQueue(ctx, "queue", now.Add(time.Hour))
+			if err != nil {
+				t.Fatalf("CountByQueue() error = %v", err)
+			}
+			if stats.Visible != tt.stored {
+				t.Errorf("queue holds %d messages, want %d", stats.Visible, tt.stored)
+			}
+		})
+	}
+}
+
+func TestSQLiteClaimPriority(t *testing.T) {
+	ctx := context.Background()
+	now := time.UnixMilli(time.Now().UnixMilli())
+	queueRepo, messageRepo := newSQLiteTestRepositories(t)
+	queue := domain.NewQueue("priority")
+	queue.PriorityQueue = true
+	queue.PriorityAging = time.Minute
+	if err := queueRepo.Save(ctx, queue); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	// Each priority level counts as one minute of waiting
+	messages := []*domain.Message{
+		{ID: "aged", SentAt: now.Add(-3 * time.Minute)},
+		{ID: "urgent", Priority: 9, SentAt: now},
+		{ID: "normal", Priority: 1, SentAt: now},
+		{ID: "high", Priority: 4, SentAt: now},
+		{ID: "normal-later", Priority: 1, SentAt: now},
+	}
+	for _, message := range messages {
+		message.QueueName = "priority"
+		message.Body = []byte(message.ID)
+		message.VisibilityTimeout = now
+		if err := messageRepo.Save(ctx, message); err != nil {
+			t.Fatalf("Save() error = %v", err)
+		}
+	}
+
+	claimed, err := messageRepo.Claim(ctx, domain.ClaimRequest{
+		Queue: queue, ReceiptHandles: []string{"h1", "h2", "h3", "h4", "h5"}, Now: now, VisibleAt: now.Add(time.Minute),
+	})
+	if err != nil {
+		t.Fatalf("Claim() error = %v", err)
+	}
+	ids := make([]string, 0, len(claimed))
+	for _, message := range claimed {
+		ids = append(ids, message.ID)
+	}
+	if want := "[urgent high aged normal normal-later]"; fmt.Sprint(ids) != want {
+		t.Errorf("claimed %v, want %s", ids, want)
+	}
+}
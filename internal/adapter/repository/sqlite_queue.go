@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"queueserver/internal/adapter/config"
+	"queueserver/internal/core/domain"
+)
+
+type SQLiteQueueRepository struct {
+	db *sql.DB
+}
+
+func NewSQLiteQueueRepository(config *config.Config) (*SQLiteQueueRepository, error) {
+	db, err := openSQLite(config.SQLitePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SQLiteQueueRepository{db: db}, nil
+}
+
+func (r *SQLiteQueueRepository) Save(ctx context.Context, queue *domain.Queue) error {
+	query := `INSERT INTO queues (` + queueColumns + `)
+              VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	if queue.CreatedAt.IsZero() {
+		queue.CreatedAt = time.Now()
+	}
+	deadLetterQueue, maxReceiveCount := redriveColumns(queue.RedrivePolicy)
+	_, err := r.db.ExecContext(ctx, query, queue.Name, toMillis(queue.CreatedAt),
+		seconds(queue.VisibilityTimeout), seconds(queue.MessageRetentionPeriod),
+		queue.MaximumMessageSize, seconds(queue.Delay), seconds(queue.ReceiveWaitTime),
+		deadLetterQueue, maxReceiveCount, queue.FifoQueue,
+		queue.ContentBasedDeduplication, seconds(queue.DeduplicationWindow),
+		queue.PriorityQueue, seconds(queue.PriorityAging), queue.Compression)
+	if err != nil {
+		return fmt.Errorf("failed to save queue: %v", err)
+	}
+	return nil
+}
+
+func (r *SQLiteQueueRepository) Update(ctx context.Context, queue *domain.Queue) error {
+	query := `UPDATE queues SET visibility_timeout = ?, message_retention_period = ?,
+                  maximum_message_size = ?, delay_seconds = ?, receive_wait_time = ?,
+                  redrive_dead_letter_queue = ?, redrive_max_receive_count = ?,
+                  content_based_deduplication = ?, deduplication_window = ?,
+                  priority_queue = ?, priority_aging = ?, compression = ?
+              WHERE name = ?`
+	deadLetterQueue, maxReceiveCount := redriveColumns(queue.RedrivePolicy)
+	result, err := r.db.ExecContext(ctx, query,
+		seconds(queue.VisibilityTimeout), seconds(queue.MessageRetentionPeriod),
+		queue.MaximumMessageSize, seconds(queue.Delay), seconds(queue.ReceiveWaitTime),
+		deadLetterQueue, maxReceiveCount,
+		queue.ContentBasedDeduplication, seconds(queue.DeduplicationWindow),
+		queue.PriorityQueue, seconds(queue.PriorityAging), queue.Compression, queue.Name)
+	if err != nil {
+		return fmt.Errorf("failed to update queue: %v", err)
+	}
+	return updatedQueue(result)
+}
+
+func (r *SQLiteQueueRepository) GetByName(ctx context.Context, name string) (*domain.Queue, error) {
+	query := `SELECT ` + queueColumns + ` FROM queues WHERE name = ?`
+	row := r.db.QueryRowContext(ctx, query, name)
+
+	queue, err := scanSQLiteQueue(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get queue: %v", err)
+	}
+	return queue, nil
+}
+
+func (r *SQLiteQueueRepository) List(ctx context.Context, prefix string, startAfter string, limit int) ([]*domain.Queue, error) {
+	query := `SELECT ` + queueColumns + ` FROM queues
+              WHERE substr(name, 1, length(?1)) = ?1 AND name > ?2
+              ORDER BY name LIMIT ?3`
+	rows, err := r.db.QueryContext(ctx, query, prefix, startAfter, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list queues: %v", err)
+	}
+	defer rows.Close()
+
+	queues := make([]*domain.Queue, 0)
+	for rows.Next() {
+		queue, err := scanSQLiteQueue(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list queues: %v", err)
+		}
+		queues = append(queues, queue)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list queues: %v", err)
+	}
+	return queues, nil
+}
+
+func (r *SQLiteQueueRepository) Delete(ctx context.Context, name string) error {
+	query := `DELETE FROM queues WHERE name = ?`
+	_, err := r.db.ExecContext(ctx, query, name)
+	if err != nil {
+		return fmt.Errorf("failed to delete queue: %v", err)
+	}
+	return nil
+}
+
+// scanSQLiteQueue reads a queue row, converting its millisecond creation time
+func scanSQLiteQueue(row rowScanner) (*domain.Queue, error) {
+	var createdAt, visibilityTimeout, retentionPeriod, delay, receiveWaitTime, deduplicationWindow, priorityAging int64
+	var deadLetterQueue string
+	var maxReceiveCount int
+	queue := &domain.Queue{}
+	if err := row.Scan(&queue.Name, &createdAt, &visibilityTimeout, &retentionPeriod,
+		&queue.MaximumMessageSize, &delay, &receiveWaitTime, &deadLetterQueue, &maxReceiveCount, &queue.FifoQueue,
+		&queue.ContentBasedDeduplication, &deduplicationWindow, &queue.PriorityQueue, &priorityAging,
+		&queue.Compression); err != nil {
+		return nil, err
+	}
+	queue.CreatedAt = fromMillis(createdAt)
+	queue.VisibilityTimeout = time.Duration(visibilityTimeout) * time.Second
+	queue.MessageRetentionPeriod = time.Duration(retentionPeriod) * time.Second
+	queue.Delay = time.Duration(delay) * time.Second
+	queue.ReceiveWaitTime = time.Duration(receiveWaitTime) * time.Second
+	queue.RedrivePolicy = redrivePolicy(deadLetterQueue, maxReceiveCount)
+	queue.DeduplicationWindow = time.Duration(deduplicationWindow) * time.Second
+	queue.PriorityAging = time.Duration(priorityAging) * time.Second
+	return queue, nil
+}
@@ -0,0 +1,138 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"queueserver/internal/adapter/config"
+	"queueserver/internal/core/domain"
+)
+
+type SQLiteScheduleRepository struct {
+	db *sql.DB
+}
+
+func NewSQLiteScheduleRepository(config *config.Config) (*SQLiteScheduleRepository, error) {
+	db, err := openSQLite(config.SQLitePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SQLiteScheduleRepository{db: db}, nil
+}
+
+func (r *SQLiteScheduleRepository) Save(ctx context.Context, schedule *domain.Schedule) error {
+	query := `INSERT INTO schedules (` + scheduleColumns + `) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	_, err := r.db.ExecContext(ctx, query, schedule.ID, schedule.QueueName, schedule.Body, schedule.MessageGroupID,
+		schedule.CronExpression, toMillis(schedule.NextFireAt), schedule.FireCount, toMillis(schedule.CreatedAt),
+		schedule.ContentType, schedule.ContentEncoding)
+	if err != nil {
+		return fmt.Errorf("failed to save schedule: %v", err)
+	}
+	return nil
+}
+
+func (r *SQLiteScheduleRepository) GetByID(ctx context.Context, id string) (*domain.Schedule, error) {
+	query := `SELECT ` + scheduleColumns + ` FROM schedules WHERE id = ?`
+	schedule, err := scanSQLiteSchedule(r.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get schedule: %v", err)
+	}
+	return schedule, nil
+}
+
+func (r *SQLiteScheduleRepository) ListByQueue(ctx context.Context, queueName string) ([]*domain.Schedule, error) {
+	query := `SELECT ` + scheduleColumns + ` FROM schedules WHERE queue_name = ? ORDER BY next_fire_at, id`
+	rows, err := r.db.QueryContext(ctx, query, queueName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list schedules: %v", err)
+	}
+	return scanSchedules(rows, scanSQLiteSchedule)
+}
+
+func (r *SQLiteScheduleRepository) Due(ctx context.Context, now time.Time, limit int) ([]*domain.Schedule, error) {
+	query := `SELECT ` + scheduleColumns + ` FROM schedules WHERE next_fire_at <= ? ORDER BY next_fire_at, id LIMIT ?`
+	rows, err := r.db.QueryContext(ctx, query, toMillis(now), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list due schedules: %v", err)
+	}
+	return scanSchedules(rows, scanSQLiteSchedule)
+}
+
+// Fire advances or deletes the schedule and inserts its message in one
+// transaction. The fire count check makes concurrent fires of the same
+// schedule enqueue a single message.
+func (r *SQLiteScheduleRepository) Fire(ctx context.Context, schedule *domain.Schedule, message *domain.Message, last bool) (bool, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to fire schedule: %v", err)
+	}
+	defer tx.Rollback()
+
+	var result sql.Result
+	if last {
+		query := `DELETE FROM schedules WHERE id = ? AND fire_count = ?`
+		result, err = tx.ExecContext(ctx, query, schedule.ID, schedule.FireCount-1)
+	} else {
+		query := `UPDATE schedules SET next_fire_at = ?, fire_count = ? WHERE id = ? AND fire_count = ?`
+		result, err = tx.ExecContext(ctx, query, toMillis(schedule.NextFireAt), schedule.FireCount, schedule.ID, schedule.FireCount-1)
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to fire schedule: %v", err)
+	}
+	fired, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to fire schedule: %v", err)
+	}
+	if fired == 0 {
+		return false, nil
+	}
+
+	if err := insertSQLiteMessages(ctx, tx, []*domain.Message{message}); err != nil {
+		return false, err
+	}
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to fire schedule: %v", err)
+	}
+	return true, nil
+}
+
+func (r *SQLiteScheduleRepository) Delete(ctx context.Context, id string) (bool, error) {
+	query := `DELETE FROM schedules WHERE id = ?`
+	result, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete schedule: %v", err)
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to delete schedule: %v", err)
+	}
+	return deleted > 0, nil
+}
+
+func (r *SQLiteScheduleRepository) DeleteByQueueName(ctx context.Context, queueName string) error {
+	query := `DELETE FROM schedules WHERE queue_name = ?`
+	if _, err := r.db.ExecContext(ctx, query, queueName); err != nil {
+		return fmt.Errorf("failed to delete queue schedules: %v", err)
+	}
+	return nil
+}
+
+// scanSQLiteSchedule reads a schedule row, converting its millisecond timestamps
+func scanSQLiteSchedule(row rowScanner) (*domain.Schedule, error) {
+	var nextFireAt, createdAt int64
+	schedule := &domain.Schedule{}
+	if err := row.Scan(&schedule.ID, &schedule.QueueName, &schedule.Body, &schedule.MessageGroupID,
+		&schedule.CronExpression, &nextFireAt, &schedule.FireCount, &createdAt,
+		&schedule.ContentType, &schedule.ContentEncoding); err != nil {
+		return nil, err
+	}
+	schedule.NextFireAt = fromMillis(nextFireAt)
+	schedule.CreatedAt = fromMillis(createdAt)
+	return schedule, nil
+}
@@ -2,8 +2,9 @@ package grpc
 
 import (
 	"context"
-	"time"
+	"errors"
 
+	"queueserver/internal/core/domain"
 	"queueserver/internal/core/port/service"
 
 	proto "queueserver/api"
@@ -22,34 +23,274 @@ func NewQueueController(queueService service.QueueService) proto.QueueServer {
 
 // SendMessage gRPC method
 func (s *queueController) SendMessage(ctx context.Context, req *proto.SendMessageRequest) (*proto.SendMessageResponse, error) {
-	messageID, err := s.queueService.SendMessage(ctx, req.QueueName, req.GetMessageBody())
+	messageID, err := s.queueService.SendMessage(ctx, req.QueueName, domain.OutgoingMessage{
+		Body:            req.GetMessageBody(),
+		MessageGroupID:  req.GetMessageGroupId(),
+		DeduplicationID: req.GetDeduplicationId(),
+		Delay:           optionalSecondsToDuration(req.DelaySeconds),
+		Priority:        optionalInt(req.Priority),
+		Attributes:      toDomainMessageAttributes(req.GetMessageAttributes()),
+		ContentType:     req.GetContentType(),
+		ContentEncoding: req.GetContentEncoding(),
+	})
 	if err != nil {
-		return nil, err
+		return nil, toStatusError(err)
 	}
 
 	return &proto.SendMessageResponse{MessageId: messageID}, nil
 }
 
-// Implement the ReceiveMessage method with visibility timeout using the Queue
-func (s *queueController) ReceiveMessage(ctx context.Context, req *proto.ReceiveMessageRequest) (*proto.ReceiveMessageResponse, error) {
-	message, err := s.queueService.ReceiveMessage(ctx, req.QueueName, time.Second*30) // 30-second visibility timeout
-	if message == nil {
-		return nil, err
+// SendMessageBatch gRPC method
+func (s *queueController) SendMessageBatch(ctx context.Context, req *proto.SendMessageBatchRequest) (*proto.SendMessageBatchResponse, error) {
+	entries := make([]domain.SendEntry, 0, len(req.GetEntries()))
+	for _, entry := range req.GetEntries() {
+		entries = append(entries, domain.SendEntry{
+			ID: entry.GetId(),
+			OutgoingMessage: domain.OutgoingMessage{
+				Body:            entry.GetMessageBody(),
+				MessageGroupID:  entry.GetMessageGroupId(),
+				DeduplicationID: entry.GetDeduplicationId(),
+				Delay:           optionalSecondsToDuration(entry.DelaySeconds),
+				Priority:        optionalInt(entry.Priority),
+				Attributes:      toDomainMessageAttributes(entry.GetMessageAttributes()),
+				ContentType:     entry.GetContentType(),
+				ContentEncoding: entry.GetContentEncoding(),
+			},
+		})
+	}
+
+	successful, failed, err := s.queueService.SendMessageBatch(ctx, req.GetQueueName(), entries)
+	if err != nil {
+		return nil, toStatusError(err)
 	}
 
-	return &proto.ReceiveMessageResponse{
-		MessageId:     message.ID,
-		MessageBody:   message.Body,
-		ReceiptHandle: message.ReceiptHandle,
+	results := make([]*proto.SendMessageBatchResultEntry, 0, len(successful))
+	for _, result := range successful {
+		results = append(results, &proto.SendMessageBatchResultEntry{Id: result.ID, MessageId: result.MessageID})
+	}
+
+	return &proto.SendMessageBatchResponse{
+		Successful: results,
+		Failed:     toProtoBatchResultErrorEntries(failed),
 	}, nil
 }
 
+// Implement the ReceiveMessage method with the visibility timeout configured on the Queue
+func (s *queueController) ReceiveMessage(ctx context.Context, req *proto.ReceiveMessageRequest) (*proto.ReceiveMessageResponse, error) {
+	opts := domain.ReceiveOptions{
+		MaxMessages:           int(req.GetMaxNumberOfMessages()),
+		AttributeNames:        req.GetAttributeNames(),
+		MessageAttributeNames: req.GetMessageAttributeNames(),
+	}
+	if req.WaitTimeSeconds != nil {
+		opts.WaitTime = secondsToDuration(req.GetWaitTimeSeconds())
+	}
+
+	messages, err := s.queueService.ReceiveMessage(ctx, req.QueueName, opts)
+	if errors.Is(err, domain.ErrNoMessageAvailable) {
+		// The wait ran out, which is not an error for the client
+		return &proto.ReceiveMessageResponse{}, nil
+	}
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return toProtoReceiveMessageResponse(messages, opts.AttributeNames), nil
+}
+
 // DeleteMessage gRPC method
 func (s *queueController) DeleteMessage(ctx context.Context, req *proto.DeleteMessageRequest) (*proto.DeleteMessageResponse, error) {
 	success, err := s.queueService.DeleteMessage(ctx, req.QueueName, req.GetReceiptHandle())
 	if err != nil {
-		return nil, err
+		return nil, toStatusError(err)
 	}
 
 	return &proto.DeleteMessageResponse{Success: success}, nil
 }
+
+// DeleteMessageBatch gRPC method
+func (s *queueController) DeleteMessageBatch(ctx context.Context, req *proto.DeleteMessageBatchRequest) (*proto.DeleteMessageBatchResponse, error) {
+	entries := make([]domain.DeleteEntry, 0, len(req.GetEntries()))
+	for _, entry := range req.GetEntries() {
+		entries = append(entries, domain.DeleteEntry{ID: entry.GetId(), ReceiptHandle: entry.GetReceiptHandle()})
+	}
+
+	successful, failed, err := s.queueService.DeleteMessageBatch(ctx, req.GetQueueName(), entries)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &proto.DeleteMessageBatchResponse{
+		Successful: successful,
+		Failed:     toProtoBatchResultErrorEntries(failed),
+	}, nil
+}
+
+// ChangeMessageVisibility gRPC method
+func (s *queueController) ChangeMessageVisibility(ctx context.Context, req *proto.ChangeMessageVisibilityRequest) (*proto.ChangeMessageVisibilityResponse, error) {
+	err := s.queueService.ChangeMessageVisibility(ctx, req.GetQueueName(), req.GetReceiptHandle(),
+		*secondsToDuration(req.GetVisibilityTimeoutSeconds()))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &proto.ChangeMessageVisibilityResponse{Success: true}, nil
+}
+
+// ChangeMessageVisibilityBatch gRPC method
+func (s *queueController) ChangeMessageVisibilityBatch(ctx context.Context, req *proto.ChangeMessageVisibilityBatchRequest) (*proto.ChangeMessageVisibilityBatchResponse, error) {
+	entries := make([]domain.VisibilityChange, 0, len(req.GetEntries()))
+	for _, entry := range req.GetEntries() {
+		entries = append(entries, domain.VisibilityChange{
+			ID:                entry.GetId(),
+			ReceiptHandle:     entry.GetReceiptHandle(),
+			VisibilityTimeout: *secondsToDuration(entry.GetVisibilityTimeoutSeconds()),
+		})
+	}
+
+	successful, failed, err := s.queueService.ChangeMessageVisibilityBatch(ctx, req.GetQueueName(), entries)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &proto.ChangeMessageVisibilityBatchResponse{
+		Successful: successful,
+		Failed:     toProtoBatchResultErrorEntries(failed),
+	}, nil
+}
+
+// CreateQueue gRPC method
+func (s *queueController) CreateQueue(ctx context.Context, req *proto.CreateQueueRequest) (*proto.CreateQueueResponse, error) {
+	queue, err := s.queueService.CreateQueue(ctx, req.GetQueueName(), toDomainQueueAttributes(req.GetAttributes()))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &proto.CreateQueueResponse{QueueName: queue.Name}, nil
+}
+
+// DeleteQueue gRPC method
+func (s *queueController) DeleteQueue(ctx context.Context, req *proto.DeleteQueueRequest) (*proto.DeleteQueueResponse, error) {
+	if err := s.queueService.DeleteQueue(ctx, req.GetQueueName()); err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &proto.DeleteQueueResponse{Success: true}, nil
+}
+
+// ListQueues gRPC method
+func (s *queueController) ListQueues(ctx context.Context, req *proto.ListQueuesRequest) (*proto.ListQueuesResponse, error) {
+	queues, nextToken, err := s.queueService.ListQueues(ctx, req.GetQueueNamePrefix(), int(req.GetMaxResults()), req.GetNextToken())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	names := make([]string, 0, len(queues))
+	for _, queue := range queues {
+		names = append(names, queue.Name)
+	}
+
+	return &proto.ListQueuesResponse{QueueNames: names, NextToken: nextToken}, nil
+}
+
+// GetQueueAttributes gRPC method
+func (s *queueController) GetQueueAttributes(ctx context.Context, req *proto.GetQueueAttributesRequest) (*proto.GetQueueAttributesResponse, error) {
+	queue, stats, err := s.queueService.GetQueueAttributes(ctx, req.GetQueueName())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &proto.GetQueueAttributesResponse{
+		QueueName:                             queue.Name,
+		CreatedTimestamp:                      queue.CreatedAt.Unix(),
+		ApproximateNumberOfMessages:           stats.Visible,
+		ApproximateNumberOfMessagesNotVisible: stats.NotVisible,
+		ApproximateNumberOfMessagesDelayed:    stats.Delayed,
+		Attributes:                            toProtoQueueAttributes(queue),
+	}, nil
+}
+
+// SetQueueAttributes gRPC method
+func (s *queueController) SetQueueAttributes(ctx context.Context, req *proto.SetQueueAttributesRequest) (*proto.SetQueueAttributesResponse, error) {
+	_, err := s.queueService.SetQueueAttributes(ctx, req.GetQueueName(), toDomainQueueAttributes(req.GetAttributes()))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &proto.SetQueueAttributesResponse{Success: true}, nil
+}
+
+// StartMessageMoveTask gRPC method
+func (s *queueController) StartMessageMoveTask(ctx context.Context, req *proto.StartMessageMoveTaskRequest) (*proto.StartMessageMoveTaskResponse, error) {
+	task, err := s.queueService.StartMessageMoveTask(ctx, req.GetSourceQueueName(), req.GetDestinationQueueName(),
+		int(req.GetMaxNumberOfMessagesPerSecond()))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &proto.StartMessageMoveTaskResponse{TaskHandle: task.Handle}, nil
+}
+
+// ListMessageMoveTasks gRPC method
+func (s *queueController) ListMessageMoveTasks(ctx context.Context, req *proto.ListMessageMoveTasksRequest) (*proto.ListMessageMoveTasksResponse, error) {
+	tasks, err := s.queueService.ListMessageMoveTasks(ctx, req.GetSourceQueueName())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	protoTasks := make([]*proto.MessageMoveTask, 0, len(tasks))
+	for _, task := range tasks {
+		protoTasks = append(protoTasks, toProtoMessageMoveTask(task))
+	}
+
+	return &proto.ListMessageMoveTasksResponse{Tasks: protoTasks}, nil
+}
+
+// CancelMessageMoveTask gRPC method
+func (s *queueController) CancelMessageMoveTask(ctx context.Context, req *proto.CancelMessageMoveTaskRequest) (*proto.CancelMessageMoveTaskResponse, error) {
+	task, err := s.queueService.CancelMessageMoveTask(ctx, req.GetTaskHandle())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &proto.CancelMessageMoveTaskResponse{ApproximateNumberOfMessagesMoved: task.MovedCount}, nil
+}
+
+// ScheduleMessage gRPC method
+func (s *queueController) ScheduleMessage(ctx context.Context, req *proto.ScheduleMessageRequest) (*proto.ScheduleMessageResponse, error) {
+	schedule, err := s.queueService.ScheduleMessage(ctx, req.GetQueueName(), domain.OutgoingMessage{
+		Body:            req.GetMessageBody(),
+		ContentType:     req.GetContentType(),
+		ContentEncoding: req.GetContentEncoding(),
+		MessageGroupID:  req.GetMessageGroupId(),
+	}, millisToTime(req.GetDeliverTimestamp()), req.GetCronExpression())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &proto.ScheduleMessageResponse{Schedule: toProtoMessageSchedule(schedule)}, nil
+}
+
+// ListSchedules gRPC method
+func (s *queueController) ListSchedules(ctx context.Context, req *proto.ListSchedulesRequest) (*proto.ListSchedulesResponse, error) {
+	schedules, err := s.queueService.ListSchedules(ctx, req.GetQueueName())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	protoSchedules := make([]*proto.MessageSchedule, 0, len(schedules))
+	for _, schedule := range schedules {
+		protoSchedules = append(protoSchedules, toProtoMessageSchedule(schedule))
+	}
+
+	return &proto.ListSchedulesResponse{Schedules: protoSchedules}, nil
+}
+
+// CancelSchedule gRPC method
+func (s *queueController) CancelSchedule(ctx context.Context, req *proto.CancelScheduleRequest) (*proto.CancelScheduleResponse, error) {
+	if err := s.queueService.CancelSchedule(ctx, req.GetScheduleId()); err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &proto.CancelScheduleResponse{}, nil
+}
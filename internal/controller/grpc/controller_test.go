@@ -0,0 +1,89 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"testing"
+
+	proto "queueserver/api"
+	"queueserver/internal/adapter/repository"
+	queueService "queueserver/internal/core/service"
+)
+
+// newTestController returns a controller over a queue service backed by the
+// memory repositories, with the named queue created
+func newTestController(t *testing.T, queueName string) proto.QueueServer {
+	t.Helper()
+	queueRepo := repository.NewMemoryQueueRepository()
+	messageRepo := repository.NewMemoryMessageRepository(queueRepo)
+	svc := queueService.NewQueueService(queueRepo, messageRepo, repository.NewMemoryScheduleRepository(messageRepo))
+	t.Cleanup(func() { svc.Close() })
+
+	controller := NewQueueController(svc)
+	if _, err := controller.CreateQueue(context.Background(), &proto.CreateQueueRequest{QueueName: queueName}); err != nil {
+		t.Fatalf("CreateQueue() error = %v", err)
+	}
+	return controller
+}
+
+func TestReceiveMessageEmptyPoll(t *testing.T) {
+	controller := newTestController(t, "queue")
+
+	noWait := int32(0)
+	resp, err := controller.ReceiveMessage(context.Background(), &proto.ReceiveMessageRequest{QueueName: "queue", WaitTimeSeconds: &noWait})
+	if err != nil {
+		t.Fatalf("ReceiveMessage() error = %v, want an empty response", err)
+	}
+	if resp.GetMessageId() != "" || len(resp.GetMessages()) != 0 {
+		t.Errorf("ReceiveMessage() = %v, want an empty response", resp)
+	}
+}
+
+func TestReceiveMessageSystemAttributes(t *testing.T) {
+	ctx := context.Background()
+	controller := newTestController(t, "queue")
+	if _, err := controller.SendMessage(ctx, &proto.SendMessageRequest{QueueName: "queue", MessageBody: []byte("body")}); err != nil {
+		t.Fatalf("SendMessage() error = %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		names []string
+		want  []string
+	}{
+		{name: "none requested"},
+		{name: "by name", names: []string{"ApproximateReceiveCount"}, want: []string{"ApproximateReceiveCount"}},
+		{name: "all", names: []string{"All"}, want: []string{"ApproximateFirstReceiveTimestamp", "ApproximateReceiveCount", "SentTimestamp"}},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			noWait := int32(0)
+			resp, err := controller.ReceiveMessage(ctx, &proto.ReceiveMessageRequest{QueueName: "queue", WaitTimeSeconds: &noWait, AttributeNames: tt.names})
+			if err != nil || len(resp.GetMessages()) != 1 {
+				t.Fatalf("ReceiveMessage() = %v, %v, want the message", resp, err)
+			}
+			message := resp.GetMessages()[0]
+
+			got := make([]string, 0, len(message.GetAttributes()))
+			for name := range message.GetAttributes() {
+				got = append(got, name)
+			}
+			sort.Strings(got)
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Fatalf("attributes = %v, want %v", got, tt.want)
+			}
+			if count, ok := message.GetAttributes()["ApproximateReceiveCount"]; ok && count != strconv.Itoa(i+1) {
+				t.Errorf("ApproximateReceiveCount = %s, want %d", count, i+1)
+			}
+
+			// Make the message visible again for the next receive
+			if _, err := controller.ChangeMessageVisibility(ctx, &proto.ChangeMessageVisibilityRequest{
+				QueueName: "queue", ReceiptHandle: message.GetReceiptHandle(),
+			}); err != nil {
+				t.Fatalf("ChangeMessageVisibility() error = %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"queueserver/internal/core/domain"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// toStatusError translates domain errors into gRPC status errors so clients
+// can tell apart missing queues, bad input and internal failures
+func toStatusError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var code codes.Code
+	switch {
+	case errors.Is(err, domain.ErrQueueNotFound),
+		errors.Is(err, domain.ErrMoveTaskNotFound),
+		errors.Is(err, domain.ErrScheduleNotFound):
+		code = codes.NotFound
+	case errors.Is(err, domain.ErrQueueAlreadyExists):
+		code = codes.AlreadyExists
+	case errors.Is(err, domain.ErrInvalidQueueName),
+		errors.Is(err, domain.ErrInvalidQueueAttribute),
+		errors.Is(err, domain.ErrInvalidNextToken),
+		errors.Is(err, domain.ErrMessageTooLarge),
+		errors.Is(err, domain.ErrReceiptHandleNotFound),
+		errors.Is(err, domain.ErrReceiptHandleMismatch),
+		errors.Is(err, domain.ErrReceiptHandleExpired),
+		errors.Is(err, domain.ErrInvalidVisibility),
+		errors.Is(err, domain.ErrInvalidDelay),
+		errors.Is(err, domain.ErrInvalidMaxNumberOfMessages),
+		errors.Is(err, domain.ErrInvalidWaitTime),
+		errors.Is(err, domain.ErrInvalidMessageGroupID),
+		errors.Is(err, domain.ErrInvalidDeduplicationID),
+		errors.Is(err, domain.ErrInvalidPriority),
+		errors.Is(err, domain.ErrInvalidMessageAttribute),
+		errors.Is(err, domain.ErrInvalidContentType),
+		errors.Is(err, domain.ErrInvalidAttributeName),
+		errors.Is(err, domain.ErrEmptyBatchRequest),
+		errors.Is(err, domain.ErrTooManyBatchEntries),
+		errors.Is(err, domain.ErrInvalidBatchEntryID),
+		errors.Is(err, domain.ErrBatchEntryIDsNotUnique),
+		errors.Is(err, domain.ErrInvalidMoveTask),
+		errors.Is(err, domain.ErrInvalidSchedule):
+		code = codes.InvalidArgument
+	case errors.Is(err, domain.ErrReceiptHandleStale),
+		errors.Is(err, domain.ErrMoveTaskRunning),
+		errors.Is(err, domain.ErrMoveTaskNotRunning):
+		code = codes.FailedPrecondition
+	case errors.Is(err, domain.ErrDeduplicationConflict):
+		code = codes.Aborted
+	case errors.Is(err, domain.ErrBlobNotFound):
+		code = codes.DataLoss
+	case errors.Is(err, context.Canceled):
+		code = codes.Canceled
+	case errors.Is(err, context.DeadlineExceeded):
+		code = codes.DeadlineExceeded
+	default:
+		return err
+	}
+
+	return status.Error(code, err.Error())
+}
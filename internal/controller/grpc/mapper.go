@@ -0,0 +1,255 @@
+package grpc
+
+import (
+	"time"
+
+	"queueserver/internal/core/domain"
+
+	proto "queueserver/api"
+
+	"google.golang.org/grpc/status"
+)
+
+// toDomainQueueAttributes converts the optional proto attributes into a
+// partial update, leaving unset fields nil
+func toDomainQueueAttributes(attrs *proto.QueueAttributes) domain.QueueAttributes {
+	result := domain.QueueAttributes{}
+	if attrs == nil {
+		return result
+	}
+
+	if attrs.VisibilityTimeoutSeconds != nil {
+		result.VisibilityTimeout = secondsToDuration(attrs.GetVisibilityTimeoutSeconds())
+	}
+	if attrs.MessageRetentionPeriodSeconds != nil {
+		result.MessageRetentionPeriod = secondsToDuration(attrs.GetMessageRetentionPeriodSeconds())
+	}
+	if attrs.MaximumMessageSize != nil {
+		size := int(attrs.GetMaximumMessageSize())
+		result.MaximumMessageSize = &size
+	}
+	if attrs.DelaySeconds != nil {
+		result.Delay = secondsToDuration(attrs.GetDelaySeconds())
+	}
+	if attrs.ReceiveMessageWaitTimeSeconds != nil {
+		result.ReceiveWaitTime = secondsToDuration(attrs.GetReceiveMessageWaitTimeSeconds())
+	}
+	if attrs.FifoQueue != nil {
+		fifo := attrs.GetFifoQueue()
+		result.FifoQueue = &fifo
+	}
+	if attrs.ContentBasedDeduplication != nil {
+		contentBased := attrs.GetContentBasedDeduplication()
+		result.ContentBasedDeduplication = &contentBased
+	}
+	if attrs.DeduplicationWindowSeconds != nil {
+		result.DeduplicationWindow = secondsToDuration(attrs.GetDeduplicationWindowSeconds())
+	}
+	if attrs.PriorityQueue != nil {
+		priorityQueue := attrs.GetPriorityQueue()
+		result.PriorityQueue = &priorityQueue
+	}
+	if attrs.PriorityAgingSeconds != nil {
+		result.PriorityAging = secondsToDuration(attrs.GetPriorityAgingSeconds())
+	}
+	if attrs.Compression != nil {
+		compression := attrs.GetCompression()
+		result.Compression = &compression
+	}
+	if policy := attrs.GetRedrivePolicy(); policy != nil {
+		result.RedrivePolicy = &domain.RedrivePolicy{
+			DeadLetterQueueName: policy.GetDeadLetterQueueName(),
+			MaxReceiveCount:     int(policy.GetMaxReceiveCount()),
+		}
+	}
+
+	return result
+}
+
+// toDomainMessageAttributes converts the attributes sent with a message
+func toDomainMessageAttributes(attrs map[string]*proto.MessageAttributeValue) domain.MessageAttributes {
+	if len(attrs) == 0 {
+		return nil
+	}
+
+	result := make(domain.MessageAttributes, len(attrs))
+	for name, attr := range attrs {
+		result[name] = domain.MessageAttribute{
+			DataType:    attr.GetDataType(),
+			StringValue: attr.GetStringValue(),
+			BinaryValue: attr.GetBinaryValue(),
+		}
+	}
+	return result
+}
+
+// toProtoQueueAttributes returns every configurable attribute of queue
+func toProtoQueueAttributes(queue *domain.Queue) *proto.QueueAttributes {
+	attrs := &proto.QueueAttributes{
+		VisibilityTimeoutSeconds:      durationToSeconds(queue.VisibilityTimeout),
+		MessageRetentionPeriodSeconds: durationToSeconds(queue.MessageRetentionPeriod),
+		MaximumMessageSize:            int32Ptr(int32(queue.MaximumMessageSize)),
+		DelaySeconds:                  durationToSeconds(queue.Delay),
+		ReceiveMessageWaitTimeSeconds: durationToSeconds(queue.ReceiveWaitTime),
+		FifoQueue:                     &queue.FifoQueue,
+		ContentBasedDeduplication:     &queue.ContentBasedDeduplication,
+		DeduplicationWindowSeconds:    durationToSeconds(queue.DeduplicationWindow),
+		PriorityQueue:                 &queue.PriorityQueue,
+		PriorityAgingSeconds:          durationToSeconds(queue.PriorityAging),
+		Compression:                   &queue.Compression,
+	}
+	if policy := queue.RedrivePolicy; policy != nil {
+		attrs.RedrivePolicy = &proto.RedrivePolicy{
+			DeadLetterQueueName: policy.DeadLetterQueueName,
+			MaxReceiveCount:     int32(policy.MaxReceiveCount),
+		}
+	}
+	return attrs
+}
+
+// toProtoReceiveMessageResponse converts the received messages, filling the
+// top-level fields from the first one for clients that receive one at a time.
+// attributeNames selects the system attributes to return.
+func toProtoReceiveMessageResponse(messages []*domain.Message, attributeNames []string) *proto.ReceiveMessageResponse {
+	received := make([]*proto.ReceivedMessage, 0, len(messages))
+	for _, message := range messages {
+		received = append(received, toProtoReceivedMessage(message, attributeNames))
+	}
+	if len(received) == 0 {
+		return &proto.ReceiveMessageResponse{}
+	}
+
+	first := received[0]
+	return &proto.ReceiveMessageResponse{
+		MessageId:             first.MessageId,
+		MessageBody:           first.MessageBody,
+		ReceiptHandle:         first.ReceiptHandle,
+		QueueName:             first.QueueName,
+		ReceiveCount:          first.ReceiveCount,
+		FirstReceiveTimestamp: first.FirstReceiveTimestamp,
+		SourceQueueName:       first.SourceQueueName,
+		Messages:              received,
+		MessageGroupId:        first.MessageGroupId,
+		SequenceNumber:        first.SequenceNumber,
+		Priority:              first.Priority,
+		Attributes:            first.Attributes,
+		MessageAttributes:     first.MessageAttributes,
+		ContentType:           first.ContentType,
+		ContentEncoding:       first.ContentEncoding,
+	}
+}
+
+// toProtoReceivedMessage converts a received message with the system
+// attributes named by attributeNames
+func toProtoReceivedMessage(message *domain.Message, attributeNames []string) *proto.ReceivedMessage {
+	received := &proto.ReceivedMessage{
+		MessageId:       message.ID,
+		MessageBody:     message.Body,
+		ReceiptHandle:   message.ReceiptHandle,
+		QueueName:       message.QueueName,
+		ReceiveCount:    int32(message.ReceiveCount),
+		SourceQueueName: message.SourceQueueName,
+		MessageGroupId:  message.MessageGroupID,
+		SequenceNumber:  message.SequenceNumber,
+		Priority:        int32(message.Priority),
+		Attributes:      message.SystemAttributes(attributeNames),
+		ContentType:     message.ContentType,
+		ContentEncoding: message.ContentEncoding,
+	}
+	if len(message.Attributes) > 0 {
+		received.MessageAttributes = make(map[string]*proto.MessageAttributeValue, len(message.Attributes))
+		for name, attr := range message.Attributes {
+			received.MessageAttributes[name] = &proto.MessageAttributeValue{
+				DataType:    attr.DataType,
+				StringValue: attr.StringValue,
+				BinaryValue: attr.BinaryValue,
+			}
+		}
+	}
+	if !message.FirstReceivedAt.IsZero() {
+		received.FirstReceiveTimestamp = message.FirstReceivedAt.UnixMilli()
+	}
+	return received
+}
+
+// toProtoMessageMoveTask converts the progress of a message move task
+func toProtoMessageMoveTask(task *domain.MoveTask) *proto.MessageMoveTask {
+	return &proto.MessageMoveTask{
+		TaskHandle:                        task.Handle,
+		Status:                            task.Status,
+		SourceQueueName:                   task.SourceQueueName,
+		DestinationQueueName:              task.DestinationQueueName,
+		MaxNumberOfMessagesPerSecond:      int32(task.MessagesPerSecond),
+		ApproximateNumberOfMessagesMoved:  task.MovedCount,
+		ApproximateNumberOfMessagesToMove: task.ToMoveCount,
+		StartedTimestamp:                  task.StartedAt.UnixMilli(),
+		FailureReason:                     task.FailureReason,
+	}
+}
+
+// toProtoMessageSchedule converts a schedule and its next fire time
+func toProtoMessageSchedule(schedule *domain.Schedule) *proto.MessageSchedule {
+	return &proto.MessageSchedule{
+		ScheduleId:        schedule.ID,
+		QueueName:         schedule.QueueName,
+		MessageBody:       schedule.Body,
+		MessageGroupId:    schedule.MessageGroupID,
+		ContentType:       schedule.ContentType,
+		ContentEncoding:   schedule.ContentEncoding,
+		CronExpression:    schedule.CronExpression,
+		NextFireTimestamp: schedule.NextFireAt.UnixMilli(),
+		FireCount:         schedule.FireCount,
+		CreatedTimestamp:  schedule.CreatedAt.UnixMilli(),
+	}
+}
+
+// millisToTime converts a Unix milliseconds timestamp, keeping 0 as the zero time
+func millisToTime(ms int64) time.Time {
+	if ms == 0 {
+		return time.Time{}
+	}
+	return time.UnixMilli(ms)
+}
+
+// toProtoBatchResultErrorEntries converts the failed entries of a batch request
+func toProtoBatchResultErrorEntries(failed []domain.BatchEntryError) []*proto.BatchResultErrorEntry {
+	entries := make([]*proto.BatchResultErrorEntry, 0, len(failed))
+	for _, entry := range failed {
+		entries = append(entries, &proto.BatchResultErrorEntry{
+			Id:      entry.ID,
+			Code:    status.Code(toStatusError(entry.Err)).String(),
+			Message: entry.Err.Error(),
+		})
+	}
+	return entries
+}
+
+func secondsToDuration(seconds int32) *time.Duration {
+	d := time.Duration(seconds) * time.Second
+	return &d
+}
+
+// optionalSecondsToDuration converts an optional field, keeping nil when unset
+func optionalSecondsToDuration(seconds *int32) *time.Duration {
+	if seconds == nil {
+		return nil
+	}
+	return secondsToDuration(*seconds)
+}
+
+// optionalInt converts an optional field, keeping nil when unset
+func optionalInt(v *int32) *int {
+	if v == nil {
+		return nil
+	}
+	n := int(*v)
+	return &n
+}
+
+func durationToSeconds(d time.Duration) *int32 {
+	return int32Ptr(int32(d / time.Second))
+}
+
+func int32Ptr(v int32) *int32 {
+	return &v
+}
@@ -0,0 +1,255 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+
+	"queueserver/internal/core/domain"
+	"queueserver/internal/core/port/service"
+
+	proto "queueserver/api"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StreamingReceive gRPC method. Messages are claimed like unary receives, so
+// the messages of a broken stream that were not acked are redelivered once
+// their visibility timeout expires.
+func (s *queueController) StreamingReceive(stream proto.Queue_StreamingReceiveServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		if err == io.EOF {
+			return nil
+		}
+		return err
+	}
+	open := req.GetOpen()
+	if open == nil {
+		return status.Error(codes.InvalidArgument, "the first message of the stream must open the session")
+	}
+	if _, _, err := s.queueService.GetQueueAttributes(stream.Context(), open.GetQueueName()); err != nil {
+		return toStatusError(err)
+	}
+	if err := domain.ValidateAttributeNames(open.GetAttributeNames(), open.GetMessageAttributeNames()); err != nil {
+		return toStatusError(err)
+	}
+
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	session := &streamSession{
+		queueService: s.queueService,
+		queueName:    open.GetQueueName(),
+		stream:       stream,
+		credits:      newStreamCredits(),
+
+		attributeNames:        open.GetAttributeNames(),
+		messageAttributeNames: open.GetMessageAttributeNames(),
+	}
+
+	pushErr := make(chan error, 1)
+	go func() {
+		pushErr <- session.push(ctx)
+	}()
+
+	requests := make(chan *proto.StreamingReceiveRequest)
+	recvErr := make(chan error, 1)
+	go func() {
+		for {
+			req, err := stream.Recv()
+			if err != nil {
+				recvErr <- err
+				return
+			}
+			select {
+			case requests <- req:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	// stop ends the session once the pushing goroutine no longer sends
+	stop := func(err error) error {
+		cancel()
+		<-pushErr
+		return err
+	}
+
+	for {
+		select {
+		case req := <-requests:
+			if err := session.handle(ctx, req); err != nil {
+				return stop(err)
+			}
+		case err := <-recvErr:
+			if err == io.EOF {
+				return stop(nil)
+			}
+			return stop(err)
+		case err := <-pushErr:
+			if err == nil && stream.Context().Err() != nil {
+				// The server is shutting down, unless the client went away
+				return status.Error(codes.Unavailable, "the stream was closed by the server")
+			}
+			return err
+		}
+	}
+}
+
+// streamSession is the state of one StreamingReceive call
+type streamSession struct {
+	queueService service.QueueService
+	queueName    string
+	stream       proto.Queue_StreamingReceiveServer
+	credits      *streamCredits
+
+	// Attributes requested when the session was opened
+	attributeNames        []string
+	messageAttributeNames []string
+
+	sendMu sync.Mutex // gRPC streams do not support concurrent sends
+}
+
+// handle applies one client message of an open session
+func (s *streamSession) handle(ctx context.Context, req *proto.StreamingReceiveRequest) error {
+	switch r := req.GetRequest().(type) {
+	case *proto.StreamingReceiveRequest_Credit:
+		if r.Credit.GetCredits() <= 0 {
+			return status.Error(codes.InvalidArgument, "credits must be positive")
+		}
+		s.credits.grant(int(r.Credit.GetCredits()))
+		return nil
+	case *proto.StreamingReceiveRequest_Ack:
+		_, err := s.queueService.DeleteMessage(ctx, s.queueName, r.Ack.GetReceiptHandle())
+		return s.sendResult(r.Ack.GetReceiptHandle(), err)
+	case *proto.StreamingReceiveRequest_Nack:
+		err := s.queueService.ChangeMessageVisibility(ctx, s.queueName, r.Nack.GetReceiptHandle(), 0)
+		return s.sendResult(r.Nack.GetReceiptHandle(), err)
+	case *proto.StreamingReceiveRequest_Extend:
+		err := s.queueService.ChangeMessageVisibility(ctx, s.queueName, r.Extend.GetReceiptHandle(),
+			*secondsToDuration(r.Extend.GetVisibilityTimeoutSeconds()))
+		return s.sendResult(r.Extend.GetReceiptHandle(), err)
+	case *proto.StreamingReceiveRequest_Open:
+		return status.Error(codes.InvalidArgument, "the session is already open")
+	default:
+		return status.Error(codes.InvalidArgument, "empty stream request")
+	}
+}
+
+// push claims messages as long as the client has credits left and sends them
+// down the stream, until ctx is cancelled
+func (s *streamSession) push(ctx context.Context) error {
+	waitTime := domain.MaxReceiveWaitTime
+	for {
+		credits, err := s.credits.wait(ctx)
+		if err != nil {
+			return nil
+		}
+		if credits > domain.MaxBatchEntries {
+			credits = domain.MaxBatchEntries
+		}
+
+		messages, err := s.queueService.ReceiveMessage(ctx, s.queueName, domain.ReceiveOptions{
+			MaxMessages:           credits,
+			WaitTime:              &waitTime,
+			AttributeNames:        s.attributeNames,
+			MessageAttributeNames: s.messageAttributeNames,
+		})
+		if ctx.Err() != nil {
+			return nil
+		}
+		if errors.Is(err, domain.ErrNoMessageAvailable) {
+			continue
+		}
+		if err != nil {
+			return toStatusError(err)
+		}
+
+		s.credits.spend(len(messages))
+		for _, message := range messages {
+			if err := s.send(&proto.StreamingReceiveResponse{
+				Response: &proto.StreamingReceiveResponse_Message{Message: toProtoReceivedMessage(message, s.attributeNames)},
+			}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// sendResult reports the outcome of an ack, nack or extend. Failures are
+// reported to the client without ending the session.
+func (s *streamSession) sendResult(receiptHandle string, err error) error {
+	result := &proto.StreamResult{
+		ReceiptHandle: receiptHandle,
+		Code:          codes.OK.String(),
+	}
+	if err != nil {
+		result.Code = status.Code(toStatusError(err)).String()
+		result.Message = err.Error()
+	}
+
+	return s.send(&proto.StreamingReceiveResponse{
+		Response: &proto.StreamingReceiveResponse_Result{Result: result},
+	})
+}
+
+func (s *streamSession) send(response *proto.StreamingReceiveResponse) error {
+	s.sendMu.Lock()
+	defer s.sendMu.Unlock()
+
+	return s.stream.Send(response)
+}
+
+// streamCredits counts the messages the client is still willing to receive
+type streamCredits struct {
+	mu      sync.Mutex
+	credits int
+	granted chan struct{}
+}
+
+func newStreamCredits() *streamCredits {
+	return &streamCredits{
+		granted: make(chan struct{}, 1),
+	}
+}
+
+func (c *streamCredits) grant(credits int) {
+	c.mu.Lock()
+	c.credits += credits
+	c.mu.Unlock()
+
+	select {
+	case c.granted <- struct{}{}:
+	default:
+	}
+}
+
+func (c *streamCredits) spend(credits int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.credits -= credits
+}
+
+// wait blocks until the client has credits left and returns how many
+func (c *streamCredits) wait(ctx context.Context) (int, error) {
+	for {
+		c.mu.Lock()
+		credits := c.credits
+		c.mu.Unlock()
+
+		if credits > 0 {
+			return credits, nil
+		}
+
+		select {
+		case <-c.granted:
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		}
+	}
+}
@@ -6,4 +6,5 @@ type GrpcServerConfig struct {
 	Port            uint32
 	KeepaliveParams keepalive.ServerParameters
 	KeepalivePolicy keepalive.EnforcementPolicy
+	MaxRecvMsgSize  int // in bytes, 0 keeps the gRPC default of 4 MiB
 }
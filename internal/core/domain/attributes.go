@@ -0,0 +1,194 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Data types of message attributes
+const (
+	AttributeTypeString = "String"
+	AttributeTypeNumber = "Number"
+	AttributeTypeBinary = "Binary"
+)
+
+// Limits of the user-defined attributes of a message
+const (
+	MaxMessageAttributes   = 10
+	MaxAttributeNameLength = 256
+)
+
+// System attributes a consumer can ask for on receive
+const (
+	AttributeSentTimestamp                    = "SentTimestamp"
+	AttributeApproximateReceiveCount          = "ApproximateReceiveCount"
+	AttributeApproximateFirstReceiveTimestamp = "ApproximateFirstReceiveTimestamp"
+)
+
+// AllAttributes selects every attribute on receive
+const AllAttributes = "All"
+
+var systemAttributes = []string{
+	AttributeSentTimestamp,
+	AttributeApproximateReceiveCount,
+	AttributeApproximateFirstReceiveTimestamp,
+}
+
+var attributeNamePattern = regexp.MustCompile(`^[A-Za-z0-9_-]+(\.[A-Za-z0-9_-]+)*$`)
+
+// MessageAttribute is a typed user-defined attribute of a message. String and
+// Number attributes use StringValue, Binary attributes use BinaryValue.
+type MessageAttribute struct {
+	DataType    string
+	StringValue string
+	BinaryValue []byte
+}
+
+// MessageAttributes maps attribute names to their values
+type MessageAttributes map[string]MessageAttribute
+
+// ValidateMessageAttributes checks the attributes sent with a message
+func ValidateMessageAttributes(attrs MessageAttributes) error {
+	if len(attrs) > MaxMessageAttributes {
+		return fmt.Errorf("%w: at most %d attributes are allowed", ErrInvalidMessageAttribute, MaxMessageAttributes)
+	}
+
+	for name, attr := range attrs {
+		if err := validateAttributeName(name); err != nil {
+			return err
+		}
+
+		switch attr.DataType {
+		case AttributeTypeString:
+			if attr.StringValue == "" || attr.BinaryValue != nil {
+				return fmt.Errorf("%w: %s must have a string value", ErrInvalidMessageAttribute, name)
+			}
+		case AttributeTypeNumber:
+			if _, err := strconv.ParseFloat(attr.StringValue, 64); err != nil || attr.BinaryValue != nil {
+				return fmt.Errorf("%w: %s must have a numeric string value", ErrInvalidMessageAttribute, name)
+			}
+		case AttributeTypeBinary:
+			if len(attr.BinaryValue) == 0 || attr.StringValue != "" {
+				return fmt.Errorf("%w: %s must have a binary value", ErrInvalidMessageAttribute, name)
+			}
+		default:
+			return fmt.Errorf("%w: %s has data type %q, must be %s, %s or %s", ErrInvalidMessageAttribute,
+				name, attr.DataType, AttributeTypeString, AttributeTypeNumber, AttributeTypeBinary)
+		}
+	}
+	return nil
+}
+
+// validateAttributeName checks the name of a user-defined attribute: dot
+// separated words of letters, digits, hyphens and underscores
+func validateAttributeName(name string) error {
+	if len(name) > MaxAttributeNameLength || !attributeNamePattern.MatchString(name) {
+		return fmt.Errorf("%w: name %q must be 1 to %d letters, digits, hyphens, underscores and inner dots",
+			ErrInvalidMessageAttribute, name, MaxAttributeNameLength)
+	}
+	return nil
+}
+
+// Size is the number of bytes the attributes add to a message: the names,
+// data types and values
+func (a MessageAttributes) Size() int {
+	size := 0
+	for name, attr := range a {
+		size += len(name) + len(attr.DataType) + len(attr.StringValue) + len(attr.BinaryValue)
+	}
+	return size
+}
+
+// Select returns the attributes matching names. A name matches itself, All
+// matches every attribute and a name ending in .* matches the attributes
+// starting with what precedes it.
+func (a MessageAttributes) Select(names []string) MessageAttributes {
+	var selected MessageAttributes
+	for name, attr := range a {
+		if !matchesAttributeName(name, names) {
+			continue
+		}
+		if selected == nil {
+			selected = make(MessageAttributes)
+		}
+		selected[name] = attr
+	}
+	return selected
+}
+
+func matchesAttributeName(name string, names []string) bool {
+	for _, pattern := range names {
+		if pattern == AllAttributes || pattern == name {
+			return true
+		}
+		if prefix, ok := strings.CutSuffix(pattern, ".*"); ok && strings.HasPrefix(name, prefix+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateAttributeNames checks the system and user-defined attribute names
+// requested on receive
+func ValidateAttributeNames(systemNames []string, messageNames []string) error {
+	for _, name := range systemNames {
+		if name != AllAttributes && !isSystemAttribute(name) {
+			return fmt.Errorf("%w: unknown system attribute %q", ErrInvalidAttributeName, name)
+		}
+	}
+	for _, name := range messageNames {
+		if name == AllAttributes {
+			continue
+		}
+		if err := validateAttributeName(strings.TrimSuffix(name, ".*")); err != nil {
+			return fmt.Errorf("%w: %q", ErrInvalidAttributeName, name)
+		}
+	}
+	return nil
+}
+
+func isSystemAttribute(name string) bool {
+	for _, attr := range systemAttributes {
+		if attr == name {
+			return true
+		}
+	}
+	return false
+}
+
+// SystemAttributes returns the system attributes of the message named by
+// names, timestamps in Unix milliseconds. The first receive timestamp is left
+// out until the message is received.
+func (m *Message) SystemAttributes(names []string) map[string]string {
+	var attrs map[string]string
+	for _, name := range systemAttributes {
+		if !matchesAttributeName(name, names) {
+			continue
+		}
+
+		var value string
+		switch name {
+		case AttributeSentTimestamp:
+			value = formatMillis(m.SentAt)
+		case AttributeApproximateReceiveCount:
+			value = strconv.Itoa(m.ReceiveCount)
+		case AttributeApproximateFirstReceiveTimestamp:
+			if m.FirstReceivedAt.IsZero() {
+				continue
+			}
+			value = formatMillis(m.FirstReceivedAt)
+		}
+		if attrs == nil {
+			attrs = make(map[string]string)
+		}
+		attrs[name] = value
+	}
+	return attrs
+}
+
+func formatMillis(t time.Time) string {
+	return strconv.FormatInt(t.UnixMilli(), 10)
+}
@@ -0,0 +1,103 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+)
+
+// MaxBatchEntries is the maximum number of entries in a batch request
+const MaxBatchEntries = 10
+
+// MaxBatchEntryIDLength is the maximum number of characters in a batch entry id
+const MaxBatchEntryIDLength = 80
+
+var batchEntryIDPattern = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// SendEntry is one message of a SendMessageBatch request
+type SendEntry struct {
+	ID string
+	OutgoingMessage
+}
+
+// SendResult identifies the message stored for a SendMessageBatch entry
+type SendResult struct {
+	ID        string
+	MessageID string
+}
+
+// DeleteEntry is one message of a DeleteMessageBatch request
+type DeleteEntry struct {
+	ID            string
+	ReceiptHandle string
+}
+
+// VisibilityChange is one entry of a ChangeMessageVisibilityBatch request
+type VisibilityChange struct {
+	ID                string
+	ReceiptHandle     string
+	VisibilityTimeout time.Duration
+}
+
+// BatchEntryError reports why the batch entry identified by ID failed
+type BatchEntryError struct {
+	ID  string
+	Err error
+}
+
+// ValidateBatchEntryIDs checks the number of entries of a batch request and
+// that their ids are valid and distinct
+func ValidateBatchEntryIDs(ids []string) error {
+	if len(ids) == 0 {
+		return ErrEmptyBatchRequest
+	}
+	if len(ids) > MaxBatchEntries {
+		return fmt.Errorf("%w: at most %d entries are allowed", ErrTooManyBatchEntries, MaxBatchEntries)
+	}
+
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		if len(id) > MaxBatchEntryIDLength || !batchEntryIDPattern.MatchString(id) {
+			return fmt.Errorf("%w: %q", ErrInvalidBatchEntryID, id)
+		}
+		if seen[id] {
+			return fmt.Errorf("%w: %q", ErrBatchEntryIDsNotUnique, id)
+		}
+		seen[id] = true
+	}
+	return nil
+}
+
+// ValidateMaxNumberOfMessages checks the number of messages asked by a
+// receive, 0 standing for a single message
+func ValidateMaxNumberOfMessages(maxMessages int) error {
+	if maxMessages < 0 || maxMessages > MaxBatchEntries {
+		return fmt.Errorf("%w: must be between 1 and %d", ErrInvalidMaxNumberOfMessages, MaxBatchEntries)
+	}
+	return nil
+}
+
+// ValidateWaitTime checks the time a receive may wait for a message
+func ValidateWaitTime(waitTime time.Duration) error {
+	if waitTime < 0 || waitTime > MaxReceiveWaitTime {
+		return fmt.Errorf("%w: must be between 0 and %d seconds", ErrInvalidWaitTime, int(MaxReceiveWaitTime/time.Second))
+	}
+	return nil
+}
+
+// ValidateVisibilityTimeout checks that a visibility timeout requested for an
+// in-flight message is within the range allowed for queues
+func ValidateVisibilityTimeout(timeout time.Duration) error {
+	if timeout < 0 || timeout > MaxVisibilityTimeout {
+		return fmt.Errorf("%w: must be between 0 and %d seconds", ErrInvalidVisibility, int(MaxVisibilityTimeout/time.Second))
+	}
+	return nil
+}
+
+// ValidateDelay checks a delivery delay requested for a single message
+func ValidateDelay(delay time.Duration) error {
+	if delay < 0 || delay > MaxDelay {
+		return fmt.Errorf("%w: must be between 0 and %d seconds", ErrInvalidDelay, int(MaxDelay/time.Second))
+	}
+	return nil
+}
@@ -0,0 +1,173 @@
+package domain
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cronSearchLimit bounds the search for the next fire time, so expressions
+// that name an impossible date such as 30 February never loop forever
+const cronSearchLimit = 5 * 366 * 24 * time.Hour
+
+// Cron shorthands and the expressions they stand for
+var cronShorthands = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// CronExpression is a parsed five-field cron expression: minute, hour, day of
+// month, month and day of week, evaluated in UTC. Fields accept *, numbers,
+// ranges (1-5), steps (*/15, 0-30/10) and comma separated lists of those. Day
+// of week 0 and 7 both stand for Sunday.
+type CronExpression struct {
+	minute, hour, dayOfMonth, month, dayOfWeek uint64 // bit i is set when value i matches
+	anyDayOfMonth, anyDayOfWeek                bool
+}
+
+// cronField is the allowed range of one field of a cron expression
+type cronField struct {
+	name     string
+	min, max int
+}
+
+var cronFields = [5]cronField{
+	{"minute", 0, 59},
+	{"hour", 0, 23},
+	{"day of month", 1, 31},
+	{"month", 1, 12},
+	{"day of week", 0, 7},
+}
+
+// ParseCronExpression parses a five-field cron expression or one of the
+// @yearly (@annually), @monthly, @weekly, @daily (@midnight) and @hourly
+// shorthands. It only checks the syntax: Next returns the zero time for an
+// expression that never fires.
+func ParseCronExpression(expr string) (*CronExpression, error) {
+	expr = strings.TrimSpace(expr)
+	if shorthand, ok := cronShorthands[expr]; ok {
+		expr = shorthand
+	}
+
+	fields := strings.Fields(expr)
+	if len(fields) != len(cronFields) {
+		return nil, fmt.Errorf("%w: cron expression %q must have 5 fields", ErrInvalidSchedule, expr)
+	}
+
+	var sets [5]uint64
+	for i, field := range fields {
+		set, err := parseCronField(field, cronFields[i])
+		if err != nil {
+			return nil, err
+		}
+		sets[i] = set
+	}
+
+	// Sunday may be written as 0 or 7
+	if sets[4]&(1<<7) != 0 {
+		sets[4] |= 1
+	}
+
+	// As in Vixie cron, a day field starting with * such as */2 does not
+	// restrict the day
+	cron := &CronExpression{
+		minute:        sets[0],
+		hour:          sets[1],
+		dayOfMonth:    sets[2],
+		month:         sets[3],
+		dayOfWeek:     sets[4],
+		anyDayOfMonth: strings.HasPrefix(fields[2], "*"),
+		anyDayOfWeek:  strings.HasPrefix(fields[4], "*"),
+	}
+	return cron, nil
+}
+
+// parseCronField returns the set of values matched by one field
+func parseCronField(field string, bounds cronField) (uint64, error) {
+	invalid := fmt.Errorf("%w: invalid %s %q, values must be between %d and %d",
+		ErrInvalidSchedule, bounds.name, field, bounds.min, bounds.max)
+
+	var set uint64
+	for _, part := range strings.Split(field, ",") {
+		rangePart, step := part, 1
+		if i := strings.Index(part, "/"); i >= 0 {
+			n, err := strconv.Atoi(part[i+1:])
+			if err != nil || n < 1 {
+				return 0, invalid
+			}
+			rangePart, step = part[:i], n
+		}
+
+		low, high := bounds.min, bounds.max
+		switch {
+		case rangePart == "*":
+		case strings.Contains(rangePart, "-"):
+			ends := strings.SplitN(rangePart, "-", 2)
+			var err error
+			if low, err = strconv.Atoi(ends[0]); err != nil {
+				return 0, invalid
+			}
+			if high, err = strconv.Atoi(ends[1]); err != nil {
+				return 0, invalid
+			}
+		default:
+			n, err := strconv.Atoi(rangePart)
+			if err != nil {
+				return 0, invalid
+			}
+			// A single value with a step runs to the end of the range
+			low = n
+			if step == 1 {
+				high = n
+			}
+		}
+		if low < bounds.min || high > bounds.max || low > high {
+			return 0, invalid
+		}
+
+		for v := low; v <= high; v += step {
+			set |= 1 << uint(v)
+		}
+	}
+	return set, nil
+}
+
+// Next returns the first time strictly after after at which the expression
+// fires, or the zero time when it never does
+func (c *CronExpression) Next(after time.Time) time.Time {
+	t := after.UTC().Truncate(time.Minute).Add(time.Minute)
+	limit := t.Add(cronSearchLimit)
+
+	for t.Before(limit) {
+		switch {
+		case c.month&(1<<uint(t.Month())) == 0:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+		case !c.matchesDay(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+		case c.hour&(1<<uint(t.Hour())) == 0:
+			t = t.Truncate(time.Hour).Add(time.Hour)
+		case c.minute&(1<<uint(t.Minute())) == 0:
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// matchesDay applies the usual cron rule: when both day fields are
+// restricted, a day matches if either of them does, else it must match both
+func (c *CronExpression) matchesDay(t time.Time) bool {
+	dayOfMonth := c.dayOfMonth&(1<<uint(t.Day())) != 0
+	dayOfWeek := c.dayOfWeek&(1<<uint(t.Weekday())) != 0
+	if c.anyDayOfMonth || c.anyDayOfWeek {
+		return dayOfMonth && dayOfWeek
+	}
+	return dayOfMonth || dayOfWeek
+}
@@ -0,0 +1,95 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseCronExpression(t *testing.T) {
+	tests := []struct {
+		name    string
+		expr    string
+		wantErr bool
+	}{
+		{name: "every minute", expr: "* * * * *"},
+		{name: "lists ranges and steps", expr: "0-30/10 9-17 1,15 * 1-5"},
+		{name: "value with a step", expr: "5/15 * * * *"},
+		{name: "sunday as 7", expr: "0 0 * * 7"},
+		{name: "shorthand", expr: "@hourly"},
+		{name: "annually", expr: "@annually"},
+		{name: "midnight", expr: "@midnight"},
+		{name: "surrounding spaces", expr: "  0 0 * * *  "},
+		{name: "empty", expr: "", wantErr: true},
+		{name: "four fields", expr: "* * * *", wantErr: true},
+		{name: "six fields", expr: "* * * * * *", wantErr: true},
+		{name: "unknown shorthand", expr: "@never", wantErr: true},
+		{name: "minute out of range", expr: "60 * * * *", wantErr: true},
+		{name: "hour out of range", expr: "* 24 * * *", wantErr: true},
+		{name: "day of month zero", expr: "* * 0 * *", wantErr: true},
+		{name: "month out of range", expr: "* * * 13 *", wantErr: true},
+		{name: "day of week out of range", expr: "* * * * 8", wantErr: true},
+		{name: "reversed range", expr: "5-1 * * * *", wantErr: true},
+		{name: "zero step", expr: "*/0 * * * *", wantErr: true},
+		{name: "not a number", expr: "a * * * *", wantErr: true},
+		{name: "never fires", expr: "0 0 30 2 *"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseCronExpression(tt.expr)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidSchedule) {
+					t.Fatalf("ParseCronExpression(%q) error = %v, want ErrInvalidSchedule", tt.expr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseCronExpression(%q) error = %v", tt.expr, err)
+			}
+		})
+	}
+}
+
+func TestCronExpressionNext(t *testing.T) {
+	// 1 January 2024 is a Monday
+	date := func(month time.Month, day, hour, minute int) time.Time {
+		return time.Date(2024, month, day, hour, minute, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name  string
+		expr  string
+		after time.Time
+		want  time.Time
+	}{
+		{name: "next quarter hour", expr: "*/15 * * * *", after: date(1, 1, 10, 7), want: date(1, 1, 10, 15)},
+		{name: "strictly after", expr: "0 2 * * *", after: date(1, 1, 2, 0), want: date(1, 2, 2, 0)},
+		{name: "seconds are dropped", expr: "* * * * *", after: date(1, 1, 10, 7).Add(30 * time.Second), want: date(1, 1, 10, 8)},
+		{name: "next month", expr: "0 0 1 * *", after: date(1, 15, 0, 0), want: date(2, 1, 0, 0)},
+		{name: "leap day", expr: "0 0 29 2 *", after: date(1, 1, 0, 0), want: date(2, 29, 0, 0)},
+		{name: "sunday as 7", expr: "0 12 * * 7", after: date(1, 1, 0, 0), want: date(1, 7, 12, 0)},
+		{name: "sunday as 0", expr: "0 12 * * 0", after: date(1, 1, 0, 0), want: date(1, 7, 12, 0)},
+		{name: "weekdays only", expr: "0 9 * * 1-5", after: date(1, 5, 10, 0), want: date(1, 8, 9, 0)},
+		// Both day fields restricted: either may match
+		{name: "day of month or day of week", expr: "0 0 3 * 1", after: date(1, 1, 0, 0), want: date(1, 3, 0, 0)},
+		// A day field starting with * does not restrict the day, so both must match
+		{name: "stepped day of month and day of week", expr: "0 0 */2 * 1", after: date(1, 1, 0, 0), want: date(1, 15, 0, 0)},
+		{name: "day of month and stepped day of week", expr: "0 0 2 * */3", after: date(1, 1, 0, 0), want: date(3, 2, 0, 0)},
+		{name: "shorthand", expr: "@monthly", after: date(1, 15, 0, 0), want: date(2, 1, 0, 0)},
+		{name: "annually", expr: "@annually", after: date(1, 15, 0, 0), want: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "midnight", expr: "@midnight", after: date(1, 15, 10, 0), want: date(1, 16, 0, 0)},
+		{name: "never fires", expr: "0 0 31 4 *", after: date(1, 1, 0, 0)},
+		{name: "evaluated in UTC", expr: "0 12 * * *", after: time.Date(2024, 1, 1, 10, 0, 0, 0, time.FixedZone("UTC-3", -3*3600)), want: date(1, 2, 12, 0)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cron, err := ParseCronExpression(tt.expr)
+			if err != nil {
+				t.Fatalf("ParseCronExpression(%q) error = %v", tt.expr, err)
+			}
+			if got := cron.Next(tt.after); !got.Equal(tt.want) {
+				t.Errorf("Next(%s) = %s, want %s", tt.after, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrInvalidQueueName           = errors.New("invalid queue name")
+	ErrInvalidQueueAttribute      = errors.New("invalid queue attribute")
+	ErrQueueNotFound              = errors.New("queue does not exist")
+	ErrQueueAlreadyExists         = errors.New("queue already exists")
+	ErrInvalidNextToken           = errors.New("invalid next token")
+	ErrMessageTooLarge            = errors.New("message body exceeds the maximum message size of the queue")
+	ErrBlobNotFound               = errors.New("offloaded message body does not exist")
+	ErrNoMessageAvailable         = errors.New("no available message")
+	ErrReceiptHandleNotFound      = errors.New("receipt handle does not match any message")
+	ErrReceiptHandleMismatch      = errors.New("receipt handle belongs to a different queue")
+	ErrReceiptHandleExpired       = errors.New("receipt handle expired, the message is no longer in flight")
+	ErrReceiptHandleStale         = errors.New("receipt handle is stale, the message was received again since")
+	ErrInvalidVisibility          = errors.New("invalid visibility timeout")
+	ErrInvalidDelay               = errors.New("invalid delivery delay")
+	ErrInvalidMaxNumberOfMessages = errors.New("invalid maximum number of messages")
+	ErrInvalidWaitTime            = errors.New("invalid wait time")
+	ErrInvalidMessageGroupID      = errors.New("invalid message group id")
+	ErrInvalidDeduplicationID     = errors.New("invalid message deduplication id")
+	ErrDeduplicationConflict      = errors.New("deduplication id changed hands while the message was sent")
+	ErrInvalidPriority            = errors.New("invalid message priority")
+	ErrInvalidMessageAttribute    = errors.New("invalid message attribute")
+	ErrInvalidContentType         = errors.New("invalid message content type")
+	ErrInvalidAttributeName       = errors.New("invalid attribute name")
+	ErrEmptyBatchRequest          = errors.New("batch request has no entries")
+	ErrTooManyBatchEntries        = errors.New("batch request has too many entries")
+	ErrInvalidBatchEntryID        = errors.New("invalid batch entry id")
+	ErrBatchEntryIDsNotUnique     = errors.New("batch entry ids are not distinct")
+	ErrInvalidMoveTask            = errors.New("invalid message move task")
+	ErrMoveTaskNotFound           = errors.New("message move task does not exist")
+	ErrMoveTaskRunning            = errors.New("a message move task is already running for the source queue")
+	ErrMoveTaskNotRunning         = errors.New("message move task is not running")
+	ErrInvalidSchedule            = errors.New("invalid schedule")
+	ErrScheduleNotFound           = errors.New("schedule does not exist")
+)
+
+func invalidAttribute(name string) error {
+	return fmt.Errorf("%w: %s is out of range", ErrInvalidQueueAttribute, name)
+}
@@ -0,0 +1,16 @@
+package domain
+
+import "time"
+
+// Types of lifecycle events
+const (
+	EventMessageExpired = "MessageExpired" // the message outlived the retention period of its queue
+)
+
+// LifecycleEvent reports a change to a message that no client asked for
+type LifecycleEvent struct {
+	Type      string
+	QueueName string
+	MessageID string
+	At        time.Time
+}
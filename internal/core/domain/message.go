@@ -1,11 +1,192 @@
 package domain
 
-import "time"
+import (
+	"fmt"
+	"regexp"
+	"time"
+)
+
+// Maximum number of characters in message group and deduplication ids
+const (
+	MaxMessageGroupIDLength  = 128
+	MaxDeduplicationIDLength = 128
+)
+
+// Range of message priorities, higher priorities are delivered first
+const (
+	MinPriority = 0
+	MaxPriority = 9
+)
+
+// MaxContentTypeLength is the maximum number of characters in the content
+// type and content encoding of a message
+const MaxContentTypeLength = 256
+
+// Message group and deduplication ids use printable ASCII characters without spaces
+var printableIDPattern = regexp.MustCompile(`^[!-~]+$`)
+
+// Content types and encodings use printable ASCII characters, spaces included
+var contentTypePattern = regexp.MustCompile(`^[ -~]*$`)
 
 type Message struct {
 	ID                string
-	Body              string
+	Body              []byte
+	ContentType       string // media type of Body set by the producer, such as application/json
+	ContentEncoding   string // encoding of Body set by the producer, such as gzip
+	BlobKey           string // key of the body in the blob store when it was offloaded, Body is then empty
+	Compression       string // codec Body is stored with, inline or in the blob store, taken from the queue on send
 	QueueName         string
-	ReceiptHandle     string
+	ReceiptHandle     string // delivery token of the latest receive, wrapped by NewReceiptHandle for consumers
 	VisibilityTimeout time.Time
+	SentAt            time.Time
+	ReceiveCount      int               // number of times the message was received
+	FirstReceivedAt   time.Time         // zero until the first receive
+	SourceQueueName   string            // queue the message was dead-lettered from, empty otherwise
+	MessageGroupID    string            // group delivered in order on FIFO queues, empty on standard queues
+	SequenceNumber    int64             // assigned by the repository on save, orders the messages of a queue
+	Priority          int               // only used by priority queues
+	Attributes        MessageAttributes // user-defined attributes, nil when there are none
+	// Deduplication is set on new messages that must not be enqueued twice.
+	// It is not stored with the message.
+	Deduplication *Deduplication
+}
+
+// OutgoingMessage is a message as sent by a producer
+type OutgoingMessage struct {
+	Body            []byte
+	ContentType     string
+	ContentEncoding string
+	MessageGroupID  string
+	DeduplicationID string         // repeats within the deduplication window of the queue are dropped
+	Delay           *time.Duration // nil uses the delay of the queue
+	Priority        *int           // only accepted by priority queues, nil for MinPriority
+	Attributes      MessageAttributes
+}
+
+// Deduplication reserves a deduplication id of a queue for a new message until
+// ExpiresAt. A message saved with an id that is still reserved is not
+// enqueued, the repository gives it the ID of the original message instead.
+type Deduplication struct {
+	ID        string
+	ExpiresAt time.Time
+}
+
+// ClaimRequest describes one receive against the stored messages of a queue
+type ClaimRequest struct {
+	Queue *Queue
+	// DeadLetterQueue is the existing queue named by the queue's redrive
+	// policy. Messages are only dead-lettered when it is set.
+	DeadLetterQueue *Queue
+	// ReceiptHandles holds one new delivery token per message to claim
+	ReceiptHandles []string
+	Now            time.Time
+	VisibleAt      time.Time
+}
+
+// ReceiveOptions tunes a receive
+type ReceiveOptions struct {
+	MaxMessages int            // 0 receives a single message
+	WaitTime    *time.Duration // nil waits for the receive wait time of the queue
+	// AttributeNames selects the system attributes to return, see
+	// Message.SystemAttributes
+	AttributeNames []string
+	// MessageAttributeNames selects the user-defined attributes to return,
+	// see MessageAttributes.Select. Messages have none when it is empty.
+	MessageAttributeNames []string
+}
+
+// ShouldDeadLetter reports whether the message exhausted the receive count
+// allowed by policy and must move to the dead-letter queue instead of being
+// delivered again
+func (m *Message) ShouldDeadLetter(policy *RedrivePolicy) bool {
+	return policy != nil && m.ReceiveCount >= policy.MaxReceiveCount
+}
+
+// DeadLetter moves the message to the dead-letter queue, remembering the queue
+// it came from and keeping its receive counters. It is visible there at now.
+func (m *Message) DeadLetter(deadLetterQueueName string, now time.Time) {
+	if m.SourceQueueName == "" {
+		m.SourceQueueName = m.QueueName
+	}
+	m.QueueName = deadLetterQueueName
+	m.VisibilityTimeout = now
+}
+
+// Redrive moves a dead-lettered message to destinationQueueName, or back to
+// the queue it came from when destinationQueueName is empty. The message is
+// visible at now and its receive counters start over.
+func (m *Message) Redrive(destinationQueueName string, now time.Time) {
+	if destinationQueueName == "" {
+		destinationQueueName = m.SourceQueueName
+	}
+	m.QueueName = destinationQueueName
+	m.SourceQueueName = ""
+	m.VisibilityTimeout = now
+	m.ReceiveCount = 0
+	m.FirstReceivedAt = time.Time{}
+}
+
+// Deliver records a receive under receiptHandle, hiding the message until visibleAt
+func (m *Message) Deliver(receiptHandle string, now time.Time, visibleAt time.Time) {
+	m.ReceiptHandle = receiptHandle
+	m.VisibilityTimeout = visibleAt
+	m.ReceiveCount++
+	if m.FirstReceivedAt.IsZero() {
+		m.FirstReceivedAt = now
+	}
+}
+
+// ValidateMessageGroupID checks a message group id sent to a FIFO queue
+func ValidateMessageGroupID(id string) error {
+	if len(id) > MaxMessageGroupIDLength || !printableIDPattern.MatchString(id) {
+		return fmt.Errorf("%w: must be 1 to %d printable ASCII characters", ErrInvalidMessageGroupID, MaxMessageGroupIDLength)
+	}
+	return nil
+}
+
+// ValidateContentType checks the content type and content encoding of a
+// message. Both are optional.
+func ValidateContentType(contentType string, contentEncoding string) error {
+	for _, value := range []string{contentType, contentEncoding} {
+		if len(value) > MaxContentTypeLength || !contentTypePattern.MatchString(value) {
+			return fmt.Errorf("%w: content type and encoding must be at most %d printable ASCII characters",
+				ErrInvalidContentType, MaxContentTypeLength)
+		}
+	}
+	return nil
+}
+
+// ValidateDeduplicationID checks a message deduplication id
+func ValidateDeduplicationID(id string) error {
+	if len(id) > MaxDeduplicationIDLength || !printableIDPattern.MatchString(id) {
+		return fmt.Errorf("%w: must be 1 to %d printable ASCII characters", ErrInvalidDeduplicationID, MaxDeduplicationIDLength)
+	}
+	return nil
+}
+
+// Delayed reports whether the message was never received and is still hidden
+// at now, waiting for its delivery delay to elapse
+func (m *Message) Delayed(now time.Time) bool {
+	return m.ReceiveCount == 0 && m.VisibilityTimeout.After(now)
+}
+
+// ValidatePriority checks the priority of a message sent to a priority queue
+func ValidatePriority(priority int) error {
+	if priority < MinPriority || priority > MaxPriority {
+		return fmt.Errorf("%w: must be between %d and %d", ErrInvalidPriority, MinPriority, MaxPriority)
+	}
+	return nil
+}
+
+// PriorityKey orders the messages of a priority queue, lowest key first. A
+// message ranks as if it had been sent one aging period earlier per priority
+// level, so waiting messages of low priority eventually overtake new
+// messages of high priority.
+func (m *Message) PriorityKey(aging time.Duration) time.Time {
+	return m.SentAt.Add(-time.Duration(m.Priority) * aging)
+}
+
+// InFlight reports whether the message was received and is still hidden at now
+func (m *Message) InFlight(now time.Time) bool {
+	return m.ReceiveCount > 0 && m.VisibilityTimeout.After(now)
 }
@@ -0,0 +1,36 @@
+package domain
+
+import "time"
+
+// Message move task statuses
+const (
+	MoveTaskRunning   = "RUNNING"
+	MoveTaskCompleted = "COMPLETED"
+	MoveTaskCancelled = "CANCELLED"
+	MoveTaskFailed    = "FAILED"
+)
+
+// Limits of the rate at which a move task moves messages
+const (
+	DefaultMoveTaskMessagesPerSecond = 100
+	MaxMoveTaskMessagesPerSecond     = 500
+)
+
+// MoveTask moves the messages of a dead-letter queue back to the queues they
+// came from, or to a chosen destination queue
+type MoveTask struct {
+	Handle               string
+	SourceQueueName      string
+	DestinationQueueName string // empty to move every message back to its source queue
+	MessagesPerSecond    int
+	Status               string
+	MovedCount           int64
+	ToMoveCount          int64 // approximate number of messages there were to move at start
+	StartedAt            time.Time
+	FailureReason        string
+}
+
+// Running reports whether the task is still moving messages
+func (t *MoveTask) Running() bool {
+	return t.Status == MoveTaskRunning
+}
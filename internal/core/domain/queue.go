@@ -1,8 +1,217 @@
 package domain
 
-import "time"
+import (
+	"fmt"
+	"regexp"
+	"time"
+)
+
+// MaxQueueNameLength is the maximum number of characters in a queue name
+const MaxQueueNameLength = 80
+
+// Default values applied to queue attributes that are not set on creation
+const (
+	DefaultVisibilityTimeout      = 30 * time.Second
+	DefaultMessageRetentionPeriod = 4 * 24 * time.Hour
+	DefaultMaximumMessageSize     = 256 * 1024
+	DefaultDelay                  = 0
+	DefaultReceiveWaitTime        = 0
+	DefaultDeduplicationWindow    = 5 * time.Minute
+	DefaultPriorityAging          = time.Minute
+)
+
+// Codecs the bodies of the messages of a queue can be compressed with at rest
+const (
+	CompressionNone = ""
+	CompressionGzip = "gzip"
+	CompressionZstd = "zstd"
+)
+
+// MaxInlineMessageSize is the largest maximum message size of a queue when
+// message bodies cannot be offloaded to a blob store
+const MaxInlineMessageSize = 256 * 1024
+
+// Allowed ranges of the queue attributes
+const (
+	MaxVisibilityTimeout      = 12 * time.Hour
+	MinMessageRetentionPeriod = time.Minute
+	MaxMessageRetentionPeriod = 14 * 24 * time.Hour
+	MinMaximumMessageSize     = 1024
+	MaxMaximumMessageSize     = 16 * 1024 * 1024
+	MaxDelay                  = 15 * time.Minute
+	MaxReceiveWaitTime        = 20 * time.Second
+	MinMaxReceiveCount        = 1
+	MaxMaxReceiveCount        = 1000
+	MinDeduplicationWindow    = time.Second
+	MaxDeduplicationWindow    = 24 * time.Hour
+	MinPriorityAging          = time.Second
+	MaxPriorityAging          = 24 * time.Hour
+)
+
+var queueNamePattern = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
 
 type Queue struct {
-	Name      string
-	CreatedAt time.Time
+	Name                   string
+	CreatedAt              time.Time
+	VisibilityTimeout      time.Duration
+	MessageRetentionPeriod time.Duration
+	MaximumMessageSize     int
+	Delay                  time.Duration
+	ReceiveWaitTime        time.Duration
+	RedrivePolicy          *RedrivePolicy // nil when messages are never dead-lettered
+	FifoQueue              bool           // delivers each message group in order, one message at a time
+	// ContentBasedDeduplication derives the deduplication id of messages sent
+	// without one from a hash of their body
+	ContentBasedDeduplication bool
+	DeduplicationWindow       time.Duration // how long a deduplication id keeps rejecting repeats
+	// PriorityQueue delivers the messages of higher priority first. Every
+	// PriorityAging a message waits counts as one more priority level, so low
+	// priority messages are never starved.
+	PriorityQueue bool
+	PriorityAging time.Duration
+	// Compression is the codec the bodies of new messages are stored with,
+	// CompressionNone stores them as sent
+	Compression string
+}
+
+// RedrivePolicy moves a message to the dead-letter queue once it has been
+// received MaxReceiveCount times without being deleted
+type RedrivePolicy struct {
+	DeadLetterQueueName string
+	MaxReceiveCount     int
+}
+
+// QueueAttributes is a partial update of the configurable queue attributes,
+// nil fields are left untouched
+type QueueAttributes struct {
+	VisibilityTimeout         *time.Duration
+	MessageRetentionPeriod    *time.Duration
+	MaximumMessageSize        *int
+	Delay                     *time.Duration
+	ReceiveWaitTime           *time.Duration
+	RedrivePolicy             *RedrivePolicy // a policy without dead-letter queue removes it
+	FifoQueue                 *bool
+	ContentBasedDeduplication *bool
+	DeduplicationWindow       *time.Duration
+	PriorityQueue             *bool
+	PriorityAging             *time.Duration
+	Compression               *string
+}
+
+// QueueStats holds the approximate message counters of a queue
+type QueueStats struct {
+	Visible    int64
+	NotVisible int64 // received and still hidden
+	Delayed    int64 // waiting for their delivery delay
+}
+
+// NewQueue creates a queue named name with the default attributes
+func NewQueue(name string) *Queue {
+	return &Queue{
+		Name:                   name,
+		CreatedAt:              time.Now(),
+		VisibilityTimeout:      DefaultVisibilityTimeout,
+		MessageRetentionPeriod: DefaultMessageRetentionPeriod,
+		MaximumMessageSize:     DefaultMaximumMessageSize,
+		Delay:                  DefaultDelay,
+		ReceiveWaitTime:        DefaultReceiveWaitTime,
+		DeduplicationWindow:    DefaultDeduplicationWindow,
+		PriorityAging:          DefaultPriorityAging,
+	}
+}
+
+// Apply validates attrs and copies every set attribute onto the queue. The
+// queue is left unchanged when any attribute is out of range.
+func (q *Queue) Apply(attrs QueueAttributes) error {
+	updated := *q
+	if attrs.VisibilityTimeout != nil {
+		updated.VisibilityTimeout = *attrs.VisibilityTimeout
+	}
+	if attrs.MessageRetentionPeriod != nil {
+		updated.MessageRetentionPeriod = *attrs.MessageRetentionPeriod
+	}
+	if attrs.MaximumMessageSize != nil {
+		updated.MaximumMessageSize = *attrs.MaximumMessageSize
+	}
+	if attrs.Delay != nil {
+		updated.Delay = *attrs.Delay
+	}
+	if attrs.ReceiveWaitTime != nil {
+		updated.ReceiveWaitTime = *attrs.ReceiveWaitTime
+	}
+	if attrs.RedrivePolicy != nil {
+		updated.RedrivePolicy = nil
+		if attrs.RedrivePolicy.DeadLetterQueueName != "" {
+			policy := *attrs.RedrivePolicy
+			updated.RedrivePolicy = &policy
+		}
+	}
+
+	if attrs.FifoQueue != nil {
+		updated.FifoQueue = *attrs.FifoQueue
+	}
+	if attrs.ContentBasedDeduplication != nil {
+		updated.ContentBasedDeduplication = *attrs.ContentBasedDeduplication
+	}
+	if attrs.DeduplicationWindow != nil {
+		updated.DeduplicationWindow = *attrs.DeduplicationWindow
+	}
+	if attrs.PriorityQueue != nil {
+		updated.PriorityQueue = *attrs.PriorityQueue
+	}
+	if attrs.PriorityAging != nil {
+		updated.PriorityAging = *attrs.PriorityAging
+	}
+	if attrs.Compression != nil {
+		updated.Compression = *attrs.Compression
+	}
+
+	if err := updated.validate(); err != nil {
+		return err
+	}
+
+	*q = updated
+	return nil
+}
+
+func (q *Queue) validate() error {
+	switch {
+	case q.VisibilityTimeout < 0 || q.VisibilityTimeout > MaxVisibilityTimeout:
+		return invalidAttribute("VisibilityTimeout")
+	case q.MessageRetentionPeriod < MinMessageRetentionPeriod || q.MessageRetentionPeriod > MaxMessageRetentionPeriod:
+		return invalidAttribute("MessageRetentionPeriod")
+	case q.MaximumMessageSize < MinMaximumMessageSize || q.MaximumMessageSize > MaxMaximumMessageSize:
+		return invalidAttribute("MaximumMessageSize")
+	case q.Delay < 0 || q.Delay > MaxDelay:
+		return invalidAttribute("DelaySeconds")
+	case q.ReceiveWaitTime < 0 || q.ReceiveWaitTime > MaxReceiveWaitTime:
+		return invalidAttribute("ReceiveMessageWaitTimeSeconds")
+	case q.DeduplicationWindow < MinDeduplicationWindow || q.DeduplicationWindow > MaxDeduplicationWindow:
+		return invalidAttribute("DeduplicationWindowSeconds")
+	case q.PriorityAging < MinPriorityAging || q.PriorityAging > MaxPriorityAging:
+		return invalidAttribute("PriorityAgingSeconds")
+	case q.PriorityQueue && q.FifoQueue:
+		return fmt.Errorf("%w: FIFO queues cannot be priority queues", ErrInvalidQueueAttribute)
+	case q.Compression != CompressionNone && q.Compression != CompressionGzip && q.Compression != CompressionZstd:
+		return fmt.Errorf("%w: Compression must be %s, %s or empty", ErrInvalidQueueAttribute, CompressionGzip, CompressionZstd)
+	}
+
+	if policy := q.RedrivePolicy; policy != nil {
+		switch {
+		case ValidateQueueName(policy.DeadLetterQueueName) != nil || policy.DeadLetterQueueName == q.Name:
+			return invalidAttribute("RedrivePolicy.DeadLetterQueueName")
+		case policy.MaxReceiveCount < MinMaxReceiveCount || policy.MaxReceiveCount > MaxMaxReceiveCount:
+			return invalidAttribute("RedrivePolicy.MaxReceiveCount")
+		}
+	}
+	return nil
+}
+
+// ValidateQueueName checks that name only uses alphanumeric characters,
+// hyphens and underscores and is at most MaxQueueNameLength long
+func ValidateQueueName(name string) error {
+	if len(name) > MaxQueueNameLength || !queueNamePattern.MatchString(name) {
+		return ErrInvalidQueueName
+	}
+	return nil
 }
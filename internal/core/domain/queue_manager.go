@@ -1,6 +1,236 @@
 package domain
 
+import (
+	"sort"
+	"time"
+)
+
+// QueueManager holds the messages of a single queue, split between the ready
+// set waiting to be received and the in-flight set of received messages that
+// have not been deleted yet. It is not safe for concurrent use.
 type QueueManager struct {
 	QueueName string
-	Messages  []*Message
+	Ready     []*Message          // in sequence number order, may hold delayed messages
+	InFlight  map[string]*Message // keyed by message ID
+}
+
+func NewQueueManager(queueName string) *QueueManager {
+	return &QueueManager{
+		QueueName: queueName,
+		Ready:     make([]*Message, 0),
+		InFlight:  make(map[string]*Message),
+	}
+}
+
+// Push adds a message to the ready set, keeping the set in sequence number order
+func (m *QueueManager) Push(message *Message) {
+	i := sort.Search(len(m.Ready), func(i int) bool {
+		return message.SequenceNumber < m.Ready[i].SequenceNumber
+	})
+	m.Ready = append(m.Ready, nil)
+	copy(m.Ready[i+1:], m.Ready[i:])
+	m.Ready[i] = message
+}
+
+// Next returns the oldest ready message visible at now without claiming it,
+// or nil when no message is visible
+func (m *QueueManager) Next(now time.Time) *Message {
+	return m.NextWhere(now, nil)
+}
+
+// NextWhere is like Next but skips the messages for which match returns false
+func (m *QueueManager) NextWhere(now time.Time, match func(*Message) bool) *Message {
+	m.requeueExpired(now)
+
+	for _, msg := range m.Ready {
+		if !msg.VisibilityTimeout.After(now) && (match == nil || match(msg)) {
+			return msg
+		}
+	}
+
+	return nil
+}
+
+// NextHead is like Next but only returns a message that heads its message
+// group, that is no older message of the group is waiting or in flight. It
+// keeps the groups of a FIFO queue in order with one message in flight each.
+func (m *QueueManager) NextHead(now time.Time) *Message {
+	m.requeueExpired(now)
+
+	blocked := make(map[string]bool)
+	for _, msg := range m.InFlight {
+		blocked[msg.MessageGroupID] = true
+	}
+	for _, msg := range m.Ready {
+		if !blocked[msg.MessageGroupID] && !msg.VisibilityTimeout.After(now) {
+			return msg
+		}
+		blocked[msg.MessageGroupID] = true
+	}
+
+	return nil
+}
+
+// NextByPriority is like Next but returns the visible message with the lowest
+// priority key, the oldest one among equals
+func (m *QueueManager) NextByPriority(now time.Time, aging time.Duration) *Message {
+	m.requeueExpired(now)
+
+	var next *Message
+	for _, msg := range m.Ready {
+		if msg.VisibilityTimeout.After(now) {
+			continue
+		}
+		if next == nil || msg.PriorityKey(aging).Before(next.PriorityKey(aging)) {
+			next = msg
+		}
+	}
+
+	return next
+}
+
+// Claim moves a ready message to the in-flight set and records the delivery
+// under a new receipt handle, hiding it until visibleAt
+func (m *QueueManager) Claim(message *Message, receiptHandle string, now time.Time, visibleAt time.Time) {
+	m.removeReady(message.ID)
+
+	message.Deliver(receiptHandle, now, visibleAt)
+	m.InFlight[message.ID] = message
+}
+
+// ChangeVisibility hides the in-flight message holding receiptHandle until
+// visibleAt and reports whether such a message was found. A visibleAt that is
+// not after now makes the message visible again.
+func (m *QueueManager) ChangeVisibility(receiptHandle string, now time.Time, visibleAt time.Time) bool {
+	for _, msg := range m.InFlight {
+		if msg.ReceiptHandle == receiptHandle && msg.InFlight(now) {
+			msg.VisibilityTimeout = visibleAt
+			return true
+		}
+	}
+	return false
+}
+
+// Find returns the message identified by messageID from either set, or nil
+func (m *QueueManager) Find(messageID string) *Message {
+	if msg, ok := m.InFlight[messageID]; ok {
+		return msg
+	}
+	for _, msg := range m.Ready {
+		if msg.ID == messageID {
+			return msg
+		}
+	}
+	return nil
+}
+
+// FindByReceiptHandle returns the message holding receiptHandle, or nil
+func (m *QueueManager) FindByReceiptHandle(receiptHandle string) *Message {
+	for _, msg := range m.InFlight {
+		if msg.ReceiptHandle == receiptHandle {
+			return msg
+		}
+	}
+	for _, msg := range m.Ready {
+		if msg.ReceiptHandle == receiptHandle {
+			return msg
+		}
+	}
+	return nil
+}
+
+// Remove deletes the message identified by messageID from either set and
+// returns it, or nil when the message is unknown to this queue
+func (m *QueueManager) Remove(messageID string) *Message {
+	if msg, ok := m.InFlight[messageID]; ok {
+		delete(m.InFlight, messageID)
+		return msg
+	}
+	return m.removeReady(messageID)
+}
+
+// SentBefore returns the messages of both sets sent before t, in sequence
+// number order
+func (m *QueueManager) SentBefore(t time.Time) []*Message {
+	messages := make([]*Message, 0)
+	for _, msg := range m.Ready {
+		if msg.SentAt.Before(t) {
+			messages = append(messages, msg)
+		}
+	}
+	for _, msg := range m.InFlight {
+		if msg.SentAt.Before(t) {
+			messages = append(messages, msg)
+		}
+	}
+	sort.Slice(messages, func(i, j int) bool {
+		return messages[i].SequenceNumber < messages[j].SequenceNumber
+	})
+	return messages
+}
+
+// Len returns the number of messages held by the queue
+func (m *QueueManager) Len() int {
+	return len(m.Ready) + len(m.InFlight)
+}
+
+// NextVisibleAt returns the earliest time after now at which a hidden message
+// of the queue becomes visible, or the zero time when none is hidden
+func (m *QueueManager) NextVisibleAt(now time.Time) time.Time {
+	next := time.Time{}
+	check := func(msg *Message) {
+		if msg.VisibilityTimeout.After(now) && (next.IsZero() || msg.VisibilityTimeout.Before(next)) {
+			next = msg.VisibilityTimeout
+		}
+	}
+	for _, msg := range m.Ready {
+		check(msg)
+	}
+	for _, msg := range m.InFlight {
+		check(msg)
+	}
+	return next
+}
+
+// Stats counts the visible, in-flight and delayed messages of the queue at now
+func (m *QueueManager) Stats(now time.Time) QueueStats {
+	stats := QueueStats{}
+	count := func(msg *Message) {
+		switch {
+		case msg.Delayed(now):
+			stats.Delayed++
+		case msg.VisibilityTimeout.After(now):
+			stats.NotVisible++
+		default:
+			stats.Visible++
+		}
+	}
+	for _, msg := range m.Ready {
+		count(msg)
+	}
+	for _, msg := range m.InFlight {
+		count(msg)
+	}
+	return stats
+}
+
+// requeueExpired returns in-flight messages whose visibility timeout elapsed
+// to the ready set
+func (m *QueueManager) requeueExpired(now time.Time) {
+	for id, msg := range m.InFlight {
+		if !msg.VisibilityTimeout.After(now) {
+			delete(m.InFlight, id)
+			m.Push(msg)
+		}
+	}
+}
+
+func (m *QueueManager) removeReady(messageID string) *Message {
+	for i, msg := range m.Ready {
+		if msg.ID == messageID {
+			m.Ready = append(m.Ready[:i], m.Ready[i+1:]...)
+			return msg
+		}
+	}
+	return nil
 }
@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"encoding/base64"
+	"strings"
+)
+
+// A receipt handle identifies one delivery of a message. It encodes the ID of
+// the message next to the delivery token that repositories store with the
+// message and replace on every receive. A handle whose token is no longer
+// stored can then be told apart: either the message is gone, or it was
+// received again since.
+
+// NewReceiptHandle returns the receipt handle of the delivery of messageID
+// under token
+func NewReceiptHandle(messageID string, token string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(messageID + ":" + token))
+}
+
+// ParseReceiptHandle returns the message ID and delivery token encoded in
+// handle. It fails with ErrReceiptHandleNotFound when handle was not made by
+// NewReceiptHandle.
+func ParseReceiptHandle(handle string) (messageID string, token string, err error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(handle)
+	if err != nil {
+		return "", "", ErrReceiptHandleNotFound
+	}
+	messageID, token, ok := strings.Cut(string(decoded), ":")
+	if !ok || messageID == "" || token == "" {
+		return "", "", ErrReceiptHandleNotFound
+	}
+	return messageID, token, nil
+}
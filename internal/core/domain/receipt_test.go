@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"encoding/base64"
+	"errors"
+	"testing"
+)
+
+func TestReceiptHandleRoundTrip(t *testing.T) {
+	tests := []struct {
+		name      string
+		messageID string
+		token     string
+	}{
+		{name: "uuids", messageID: "0b9e4f3c-6f0e-4b8e-9d52-3c1f8a7e2d10", token: "5d2c7a1e-8b3f-4c6d-a9e0-1f4b7c2d8e93"},
+		{name: "token with a colon", messageID: "id", token: "to:ken"},
+		{name: "single characters", messageID: "a", token: "b"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handle := NewReceiptHandle(tt.messageID, tt.token)
+			messageID, token, err := ParseReceiptHandle(handle)
+			if err != nil {
+				t.Fatalf("ParseReceiptHandle(%q) error = %v", handle, err)
+			}
+			if messageID != tt.messageID || token != tt.token {
+				t.Errorf("ParseReceiptHandle(%q) = %q, %q, want %q, %q", handle, messageID, token, tt.messageID, tt.token)
+			}
+		})
+	}
+}
+
+func TestParseReceiptHandleInvalid(t *testing.T) {
+	encode := func(s string) string {
+		return base64.RawURLEncoding.EncodeToString([]byte(s))
+	}
+
+	tests := []struct {
+		name   string
+		handle string
+	}{
+		{name: "empty", handle: ""},
+		{name: "not base64", handle: "not a handle!"},
+		{name: "padded base64", handle: base64.URLEncoding.EncodeToString([]byte("id:token"))},
+		{name: "no separator", handle: encode("idtoken")},
+		{name: "no message id", handle: encode(":token")},
+		{name: "no token", handle: encode("id:")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := ParseReceiptHandle(tt.handle); !errors.Is(err, ErrReceiptHandleNotFound) {
+				t.Errorf("ParseReceiptHandle(%q) error = %v, want ErrReceiptHandleNotFound", tt.handle, err)
+			}
+		})
+	}
+}
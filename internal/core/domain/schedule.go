@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// Schedule enqueues a message into a queue once at a fixed time, or every
+// time its cron expression fires
+type Schedule struct {
+	ID              string
+	QueueName       string
+	Body            []byte
+	ContentType     string
+	ContentEncoding string
+	MessageGroupID  string
+	CronExpression  string    // empty for a one-off schedule
+	NextFireAt      time.Time // time of the next message
+	FireCount       int64     // number of messages enqueued so far
+	CreatedAt       time.Time
+}
+
+// Recurring reports whether the schedule fires on a cron expression
+func (s *Schedule) Recurring() bool {
+	return s.CronExpression != ""
+}
+
+// Advance records a fire at now and moves NextFireAt to the next fire time of
+// the cron expression after now, so fires missed while the server was down
+// are not replayed one by one. It reports false when the schedule has no fire
+// left and must be removed.
+func (s *Schedule) Advance(now time.Time) (bool, error) {
+	s.FireCount++
+	if !s.Recurring() {
+		return false, nil
+	}
+
+	cron, err := ParseCronExpression(s.CronExpression)
+	if err != nil {
+		return false, err
+	}
+	s.NextFireAt = cron.Next(now)
+	return !s.NextFireAt.IsZero(), nil
+}
+
+// ValidateScheduleTiming checks that exactly one of a fire time and a cron
+// expression is set, and that the cron expression fires after now. It returns
+// the first fire time.
+func ValidateScheduleTiming(deliverAt time.Time, cronExpression string, now time.Time) (time.Time, error) {
+	switch {
+	case deliverAt.IsZero() && cronExpression == "":
+		return time.Time{}, fmt.Errorf("%w: a delivery time or a cron expression is required", ErrInvalidSchedule)
+	case !deliverAt.IsZero() && cronExpression != "":
+		return time.Time{}, fmt.Errorf("%w: a delivery time and a cron expression cannot be combined", ErrInvalidSchedule)
+	case cronExpression != "":
+		cron, err := ParseCronExpression(cronExpression)
+		if err != nil {
+			return time.Time{}, err
+		}
+		next := cron.Next(now)
+		if next.IsZero() {
+			return time.Time{}, fmt.Errorf("%w: cron expression %q never fires", ErrInvalidSchedule, cronExpression)
+		}
+		return next, nil
+	default:
+		return deliverAt, nil
+	}
+}
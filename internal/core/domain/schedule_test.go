@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestValidateScheduleTiming(t *testing.T) {
+	now := time.Date(2024, 1, 1, 10, 7, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		deliverAt      time.Time
+		cronExpression string
+		want           time.Time
+		wantErr        bool
+	}{
+		{name: "delivery time", deliverAt: now.Add(time.Hour), want: now.Add(time.Hour)},
+		{name: "cron expression", cronExpression: "*/15 * * * *", want: now.Add(8 * time.Minute)},
+		{name: "neither", wantErr: true},
+		{name: "both", deliverAt: now.Add(time.Hour), cronExpression: "* * * * *", wantErr: true},
+		{name: "invalid cron expression", cronExpression: "* * *", wantErr: true},
+		{name: "cron expression that never fires", cronExpression: "0 0 30 2 *", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ValidateScheduleTiming(tt.deliverAt, tt.cronExpression, now)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidSchedule) {
+					t.Fatalf("ValidateScheduleTiming() error = %v, want ErrInvalidSchedule", err)
+				}
+				return
+			}
+			if err != nil || !got.Equal(tt.want) {
+				t.Fatalf("ValidateScheduleTiming() = %s, %v, want %s", got, err, tt.want)
+			}
+		})
+	}
+}
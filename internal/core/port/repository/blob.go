@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+// BlobStore keeps the message bodies offloaded out of the message repository.
+// Bodies are stored under the ID of their message.
+type BlobStore interface {
+	// Put compresses data with codec and stores it under key, replacing any blob
+	// already there. It returns the codec the blob was stored with, which may be
+	// domain.CompressionNone when compression would not make it smaller.
+	Put(ctx context.Context, key string, data []byte, codec string) (string, error)
+	// Get returns the blob stored under key decompressed with the codec Put
+	// returned, or domain.ErrBlobNotFound
+	Get(ctx context.Context, key string, codec string) ([]byte, error)
+	// Delete removes the blobs stored under keys, skipping those that do not exist
+	Delete(ctx context.Context, keys []string) error
+	// List returns the keys of the blobs stored before storedBefore
+	List(ctx context.Context, storedBefore time.Time) ([]string, error)
+}
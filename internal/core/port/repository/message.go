@@ -2,12 +2,56 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"queueserver/internal/core/domain"
 )
 
+// MessageRepository stores messages. The receipt handles it deals with are
+// the delivery tokens of Message.ReceiptHandle, replaced on every Claim.
 type MessageRepository interface {
 	Save(ctx context.Context, message *domain.Message) error
+	// SaveBatch inserts new messages, in a single round trip unless some carry
+	// a Deduplication. Those whose deduplication id is still reserved on their
+	// queue are skipped and take the ID of the original message; the others
+	// reserve their id in the same transaction as their insert.
+	SaveBatch(ctx context.Context, messages []*domain.Message) error
 	GetByMessageID(ctx context.Context, messageId string) (*domain.Message, error)
+	GetByReceiptHandle(ctx context.Context, receiptHandle string) (*domain.Message, error)
+	// Claim atomically delivers up to len(req.ReceiptHandles) of the oldest
+	// visible messages of the queue, one under each delivery token, first moving
+	// visible messages that exhausted the redrive policy to the dead-letter
+	// queue. It returns an empty slice when none is visible.
+	Claim(ctx context.Context, req domain.ClaimRequest) ([]*domain.Message, error)
+	// MoveNext atomically moves the oldest visible message of sourceQueueName to
+	// destinationQueueName, or back to the queue it was dead-lettered from when
+	// destinationQueueName is empty. Messages whose destination queue does not
+	// exist are skipped. It returns nil when nothing is left to move.
+	MoveNext(ctx context.Context, sourceQueueName string, destinationQueueName string, now time.Time) (*domain.Message, error)
+	// CountMovable returns the number of messages MoveNext would move at now
+	CountMovable(ctx context.Context, sourceQueueName string, destinationQueueName string, now time.Time) (int64, error)
+	// NextVisibleAt returns the earliest time after now at which a hidden
+	// message of queueName becomes visible, or the zero time when none is hidden
+	NextVisibleAt(ctx context.Context, queueName string, now time.Time) (time.Time, error)
+	CountByQueue(ctx context.Context, queueName string, now time.Time) (domain.QueueStats, error)
+	// ChangeVisibility hides the in-flight message of queueName holding
+	// receiptHandle until visibleAt and reports whether such a message was found.
+	ChangeVisibility(ctx context.Context, queueName string, receiptHandle string, now time.Time, visibleAt time.Time) (bool, error)
 	Delete(ctx context.Context, messageId string) error
+	DeleteByReceiptHandle(ctx context.Context, queueName string, receiptHandle string) (bool, error)
+	// DeleteByReceiptHandles deletes the messages of queueName holding any of
+	// receiptHandles in a single round trip and returns the handles it deleted
+	DeleteByReceiptHandles(ctx context.Context, queueName string, receiptHandles []string) ([]string, error)
+	// DeleteExpired deletes up to limit messages of queueName sent before
+	// sentBefore, in flight or not, and returns them
+	DeleteExpired(ctx context.Context, queueName string, sentBefore time.Time, limit int) ([]*domain.Message, error)
+	// PurgeDeduplication drops the deduplication ids of every queue that
+	// expired at now
+	PurgeDeduplication(ctx context.Context, now time.Time) error
+	// DeleteByQueueName deletes the messages of queueName along with its
+	// deduplication ids
+	DeleteByQueueName(ctx context.Context, queueName string) error
+	// ReferencedBlobKeys returns those of keys still referenced by a message,
+	// in a single round trip
+	ReferencedBlobKeys(ctx context.Context, keys []string) ([]string, error)
 }
@@ -8,6 +8,11 @@ import (
 
 type QueueRepository interface {
 	Save(ctx context.Context, message *domain.Queue) error
+	// Update returns domain.ErrQueueNotFound when the queue does not exist
+	Update(ctx context.Context, queue *domain.Queue) error
 	GetByName(ctx context.Context, name string) (*domain.Queue, error)
+	// List returns up to limit queues ordered by name, whose name starts with
+	// prefix and sorts after startAfter
+	List(ctx context.Context, prefix string, startAfter string, limit int) ([]*domain.Queue, error)
 	Delete(ctx context.Context, name string) error
 }
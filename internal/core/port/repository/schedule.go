@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"queueserver/internal/core/domain"
+)
+
+type ScheduleRepository interface {
+	Save(ctx context.Context, schedule *domain.Schedule) error
+	GetByID(ctx context.Context, id string) (*domain.Schedule, error)
+	// ListByQueue returns the schedules of queueName, earliest next fire first
+	ListByQueue(ctx context.Context, queueName string) ([]*domain.Schedule, error)
+	// Due returns up to limit schedules whose next fire time is not after now,
+	// earliest first
+	Due(ctx context.Context, now time.Time, limit int) ([]*domain.Schedule, error)
+	// Fire atomically enqueues message and stores schedule as advanced by the
+	// fire, or deletes it when last is set. Nothing happens unless the stored
+	// schedule was fired exactly schedule.FireCount-1 times, so a fire that
+	// raced with another replica or a cancellation reports false.
+	Fire(ctx context.Context, schedule *domain.Schedule, message *domain.Message, last bool) (bool, error)
+	// Delete removes a schedule and reports whether it existed
+	Delete(ctx context.Context, id string) (bool, error)
+	DeleteByQueueName(ctx context.Context, queueName string) error
+}
@@ -2,13 +2,34 @@ package service
 
 import (
 	"context"
+	"io"
 	"time"
 
 	"queueserver/internal/core/domain"
 )
 
 type QueueService interface {
-	SendMessage(ctx context.Context, queueName string, body string) (string, error)
-	ReceiveMessage(ctx context.Context, queueName string, timeout time.Duration) (*domain.Message, error)
+	io.Closer
+
+	CreateQueue(ctx context.Context, queueName string, attrs domain.QueueAttributes) (*domain.Queue, error)
+	DeleteQueue(ctx context.Context, queueName string) error
+	ListQueues(ctx context.Context, prefix string, maxResults int, nextToken string) ([]*domain.Queue, string, error)
+	GetQueueAttributes(ctx context.Context, queueName string) (*domain.Queue, *domain.QueueStats, error)
+	SetQueueAttributes(ctx context.Context, queueName string, attrs domain.QueueAttributes) (*domain.Queue, error)
+
+	SendMessage(ctx context.Context, queueName string, message domain.OutgoingMessage) (string, error)
+	SendMessageBatch(ctx context.Context, queueName string, entries []domain.SendEntry) ([]domain.SendResult, []domain.BatchEntryError, error)
+	ReceiveMessage(ctx context.Context, queueName string, opts domain.ReceiveOptions) ([]*domain.Message, error)
 	DeleteMessage(ctx context.Context, queueName string, receiptHandle string) (bool, error)
+	DeleteMessageBatch(ctx context.Context, queueName string, entries []domain.DeleteEntry) ([]string, []domain.BatchEntryError, error)
+	ChangeMessageVisibility(ctx context.Context, queueName string, receiptHandle string, timeout time.Duration) error
+	ChangeMessageVisibilityBatch(ctx context.Context, queueName string, entries []domain.VisibilityChange) ([]string, []domain.BatchEntryError, error)
+
+	StartMessageMoveTask(ctx context.Context, sourceQueueName string, destinationQueueName string, messagesPerSecond int) (*domain.MoveTask, error)
+	ListMessageMoveTasks(ctx context.Context, sourceQueueName string) ([]*domain.MoveTask, error)
+	CancelMessageMoveTask(ctx context.Context, taskHandle string) (*domain.MoveTask, error)
+
+	ScheduleMessage(ctx context.Context, queueName string, message domain.OutgoingMessage, deliverAt time.Time, cronExpression string) (*domain.Schedule, error)
+	ListSchedules(ctx context.Context, queueName string) ([]*domain.Schedule, error)
+	CancelSchedule(ctx context.Context, scheduleID string) error
 }
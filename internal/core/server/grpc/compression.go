@@ -0,0 +1,52 @@
+package grpc
+
+import (
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+	"google.golang.org/grpc/encoding"
+	_ "google.golang.org/grpc/encoding/gzip" // registers the gzip compressor
+)
+
+// Clients that send requests compressed with a registered compressor get
+// their responses compressed the same way
+func init() {
+	encoding.RegisterCompressor(zstdCompressor{})
+}
+
+// zstdCompressor compresses gRPC messages with zstd
+type zstdCompressor struct{}
+
+func (zstdCompressor) Name() string {
+	return "zstd"
+}
+
+func (zstdCompressor) Compress(w io.Writer) (io.WriteCloser, error) {
+	return zstd.NewWriter(w, zstd.WithEncoderConcurrency(1))
+}
+
+func (zstdCompressor) Decompress(r io.Reader) (io.Reader, error) {
+	decoder, err := zstd.NewReader(r, zstd.WithDecoderConcurrency(1))
+	if err != nil {
+		return nil, err
+	}
+	return &zstdReader{decoder: decoder}, nil
+}
+
+// zstdReader releases its decoder once the message is read
+type zstdReader struct {
+	decoder *zstd.Decoder
+	done    bool
+}
+
+func (z *zstdReader) Read(p []byte) (int, error) {
+	if z.done {
+		return 0, io.EOF
+	}
+	n, err := z.decoder.Read(p)
+	if err != nil {
+		z.done = true
+		z.decoder.Close()
+	}
+	return n, err
+}
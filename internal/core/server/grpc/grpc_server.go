@@ -1,6 +1,7 @@
 package grpc
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net"
@@ -18,30 +19,68 @@ type GRPCServer interface {
 	io.Closer
 }
 
+// shutdownTimeout bounds how long Close waits for the running calls before
+// cutting them off
+const shutdownTimeout = 30 * time.Second
+
 type gRPCServer struct {
 	grpcServer *grpc.Server
 	config     config.GrpcServerConfig
+	// closeStreams cancels the context of the open streams on shutdown
+	closeStreams context.CancelFunc
 }
 
 func NewGrpcServer(config config.GrpcServerConfig) (GRPCServer, error) {
-	options, err := buildOptions(config)
+	shutdown, closeStreams := context.WithCancel(context.Background())
+	options, err := buildOptions(config, shutdown)
 	if err != nil {
+		closeStreams()
 		return nil, err
 	}
 
 	server := grpc.NewServer(options...)
 
 	return &gRPCServer{
-		config:     config,
-		grpcServer: server,
+		config:       config,
+		grpcServer:   server,
+		closeStreams: closeStreams,
 	}, err
 }
 
-func buildOptions(config config.GrpcServerConfig) ([]grpc.ServerOption, error) {
-	return []grpc.ServerOption{
+func buildOptions(config config.GrpcServerConfig, shutdown context.Context) ([]grpc.ServerOption, error) {
+	options := []grpc.ServerOption{
 		grpc.KeepaliveParams(buildKeepaliveParams(config.KeepaliveParams)),
 		grpc.KeepaliveEnforcementPolicy(buildKeepalivePolicy(config.KeepalivePolicy)),
-	}, nil
+		grpc.StreamInterceptor(cancelOnShutdown(shutdown)),
+	}
+	if config.MaxRecvMsgSize > 0 {
+		options = append(options, grpc.MaxRecvMsgSize(config.MaxRecvMsgSize))
+	}
+	return options, nil
+}
+
+// cancelOnShutdown cancels the context of every open stream once shutdown is
+// done. Streams such as StreamingReceive only end when the client hangs up,
+// so GracefulStop would otherwise wait for them forever.
+func cancelOnShutdown(shutdown context.Context) grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, cancel := context.WithCancel(stream.Context())
+		defer cancel()
+		stop := context.AfterFunc(shutdown, cancel)
+		defer stop()
+
+		return handler(srv, &shutdownStream{ServerStream: stream, ctx: ctx})
+	}
+}
+
+// shutdownStream is a server stream whose context is cancelled on shutdown
+type shutdownStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *shutdownStream) Context() context.Context {
+	return s.ctx
 }
 
 func buildKeepalivePolicy(config keepalive.EnforcementPolicy) keepalive.EnforcementPolicy {
@@ -77,6 +116,21 @@ func (g gRPCServer) Start(serviceRegister func(server *grpc.Server)) {
 
 func (g gRPCServer) Close() error {
 	fmt.Print("close gRPC server")
-	g.grpcServer.GracefulStop()
+	g.closeStreams()
+
+	stopped := make(chan struct{})
+	go func() {
+		g.grpcServer.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-time.After(shutdownTimeout):
+		// A call ignored the cancellation, such as a send blocked on a client
+		// that stopped reading
+		g.grpcServer.Stop()
+		<-stopped
+	}
 	return nil
 }
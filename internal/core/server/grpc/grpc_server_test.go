@@ -0,0 +1,143 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	proto "queueserver/api"
+	"queueserver/internal/adapter/repository"
+	controller "queueserver/internal/controller/grpc"
+	"queueserver/internal/core/config"
+	queueService "queueserver/internal/core/service"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// newTestServer serves the queue controller over an in-memory connection and
+// returns the server and a client connected to it
+func newTestServer(t *testing.T) (*gRPCServer, proto.QueueClient) {
+	t.Helper()
+	queueRepo := repository.NewMemoryQueueRepository()
+	messageRepo := repository.NewMemoryMessageRepository(queueRepo)
+	svc := queueService.NewQueueService(queueRepo, messageRepo, repository.NewMemoryScheduleRepository(messageRepo))
+	t.Cleanup(func() { svc.Close() })
+
+	server, err := NewGrpcServer(config.GrpcServerConfig{})
+	if err != nil {
+		t.Fatalf("NewGrpcServer() error = %v", err)
+	}
+	g := server.(*gRPCServer)
+	proto.RegisterQueueServer(g.grpcServer, controller.NewQueueController(svc))
+
+	listener := bufconn.Listen(1024 * 1024)
+	go g.grpcServer.Serve(listener)
+	t.Cleanup(func() { g.Close() })
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	return g, proto.NewQueueClient(conn)
+}
+
+// streamMessage receives the next response of the stream and returns the
+// pushed message it holds
+func streamMessage(t *testing.T, stream proto.Queue_StreamingReceiveClient) *proto.ReceivedMessage {
+	t.Helper()
+	resp, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("Recv() error = %v", err)
+	}
+	if resp.GetMessage() == nil {
+		t.Fatalf("Recv() = %v, want a message", resp)
+	}
+	return resp.GetMessage()
+}
+
+func TestStreamingReceive(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	server, client := newTestServer(t)
+
+	if _, err := client.CreateQueue(ctx, &proto.CreateQueueRequest{QueueName: "queue"}); err != nil {
+		t.Fatalf("CreateQueue() error = %v", err)
+	}
+	for _, body := range []string{"one", "two", "three"} {
+		if _, err := client.SendMessage(ctx, &proto.SendMessageRequest{QueueName: "queue", MessageBody: []byte(body)}); err != nil {
+			t.Fatalf("SendMessage() error = %v", err)
+		}
+	}
+
+	stream, err := client.StreamingReceive(ctx)
+	if err != nil {
+		t.Fatalf("StreamingReceive() error = %v", err)
+	}
+	send := func(req *proto.StreamingReceiveRequest) {
+		t.Helper()
+		if err := stream.Send(req); err != nil {
+			t.Fatalf("Send() error = %v", err)
+		}
+	}
+	credit := func(credits int32) {
+		send(&proto.StreamingReceiveRequest{Request: &proto.StreamingReceiveRequest_Credit{Credit: &proto.StreamCredit{Credits: credits}}})
+	}
+	send(&proto.StreamingReceiveRequest{Request: &proto.StreamingReceiveRequest_Open{Open: &proto.StreamOpen{QueueName: "queue"}}})
+
+	// Two credits deliver two messages and leave the third in the queue
+	credit(2)
+	first := streamMessage(t, stream)
+	second := streamMessage(t, stream)
+	if string(first.GetMessageBody()) != "one" || string(second.GetMessageBody()) != "two" {
+		t.Fatalf("pushed %q and %q, want %q and %q", first.GetMessageBody(), second.GetMessageBody(), "one", "two")
+	}
+	time.Sleep(100 * time.Millisecond)
+	attrs, err := client.GetQueueAttributes(ctx, &proto.GetQueueAttributesRequest{QueueName: "queue"})
+	if err != nil {
+		t.Fatalf("GetQueueAttributes() error = %v", err)
+	}
+	if visible := attrs.GetApproximateNumberOfMessages(); visible != 1 {
+		t.Fatalf("%d messages left visible without credits, want 1", visible)
+	}
+
+	credit(1)
+	if third := streamMessage(t, stream); string(third.GetMessageBody()) != "three" {
+		t.Fatalf("pushed %q, want %q", third.GetMessageBody(), "three")
+	}
+
+	send(&proto.StreamingReceiveRequest{Request: &proto.StreamingReceiveRequest_Ack{Ack: &proto.StreamAck{ReceiptHandle: first.GetReceiptHandle()}}})
+	resp, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("Recv() error = %v", err)
+	}
+	if result := resp.GetResult(); result.GetReceiptHandle() != first.GetReceiptHandle() || result.GetCode() != codes.OK.String() {
+		t.Fatalf("ack result = %v, want OK for %s", resp, first.GetReceiptHandle())
+	}
+
+	// Shutting down ends the open stream instead of waiting for the client
+	credit(1)
+	closed := make(chan struct{})
+	go func() {
+		server.Close()
+		close(closed)
+	}()
+	if _, err := stream.Recv(); status.Code(err) != codes.Unavailable {
+		t.Fatalf("Recv() after shutdown error = %v, want %s", err, codes.Unavailable)
+	}
+	select {
+	case <-closed:
+	case <-ctx.Done():
+		t.Fatal("Close() is still waiting for the stream")
+	}
+}
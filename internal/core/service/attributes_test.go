@@ -0,0 +1,128 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"queueserver/internal/core/domain"
+)
+
+func TestSendMessageAttributes(t *testing.T) {
+	maxSize := domain.MinMaximumMessageSize
+	tooMany := make(domain.MessageAttributes)
+	for i := 0; i <= domain.MaxMessageAttributes; i++ {
+		tooMany[string(rune('a'+i))] = domain.MessageAttribute{DataType: domain.AttributeTypeString, StringValue: "value"}
+	}
+
+	tests := []struct {
+		name    string
+		attrs   domain.MessageAttributes
+		body    string
+		wantErr error
+	}{
+		{
+			name: "every data type",
+			attrs: domain.MessageAttributes{
+				"trace.id": {DataType: domain.AttributeTypeString, StringValue: "abc"},
+				"retries":  {DataType: domain.AttributeTypeNumber, StringValue: "-1.5e3"},
+				"checksum": {DataType: domain.AttributeTypeBinary, BinaryValue: []byte{0, 1}},
+			},
+		},
+		{name: "empty string", attrs: domain.MessageAttributes{"a": {DataType: domain.AttributeTypeString}}, wantErr: domain.ErrInvalidMessageAttribute},
+		{name: "number that does not parse", attrs: domain.MessageAttributes{"a": {DataType: domain.AttributeTypeNumber, StringValue: "ten"}}, wantErr: domain.ErrInvalidMessageAttribute},
+		{name: "empty binary", attrs: domain.MessageAttributes{"a": {DataType: domain.AttributeTypeBinary}}, wantErr: domain.ErrInvalidMessageAttribute},
+		{name: "string with a binary value", attrs: domain.MessageAttributes{"a": {DataType: domain.AttributeTypeString, StringValue: "x", BinaryValue: []byte{1}}}, wantErr: domain.ErrInvalidMessageAttribute},
+		{name: "unknown data type", attrs: domain.MessageAttributes{"a": {DataType: "Date", StringValue: "x"}}, wantErr: domain.ErrInvalidMessageAttribute},
+		{name: "invalid name", attrs: domain.MessageAttributes{"trace..id": {DataType: domain.AttributeTypeString, StringValue: "x"}}, wantErr: domain.ErrInvalidMessageAttribute},
+		{name: "too many attributes", attrs: tooMany, wantErr: domain.ErrInvalidMessageAttribute},
+		{
+			name:    "attributes count towards the size",
+			attrs:   domain.MessageAttributes{"a": {DataType: domain.AttributeTypeString, StringValue: "x"}},
+			body:    strings.Repeat("x", maxSize-len("a")-len(domain.AttributeTypeString)),
+			wantErr: domain.ErrMessageTooLarge,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := newTestService(t)
+			createQueue(t, svc, "queue", domain.QueueAttributes{MaximumMessageSize: &maxSize})
+
+			body := tt.body
+			if body == "" {
+				body = "body"
+			}
+			_, err := svc.SendMessage(context.Background(), "queue", domain.OutgoingMessage{Body: []byte(body), Attributes: tt.attrs})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("SendMessage() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestReceiveMessageAttributes(t *testing.T) {
+	attrs := domain.MessageAttributes{
+		"trace.id":   {DataType: domain.AttributeTypeString, StringValue: "abc"},
+		"trace.span": {DataType: domain.AttributeTypeString, StringValue: "def"},
+		"retries":    {DataType: domain.AttributeTypeNumber, StringValue: "3"},
+	}
+
+	tests := []struct {
+		name    string
+		names   []string
+		want    []string
+		wantErr error
+	}{
+		{name: "none requested"},
+		{name: "by name", names: []string{"retries", "missing"}, want: []string{"retries"}},
+		{name: "by prefix", names: []string{"trace.*"}, want: []string{"trace.id", "trace.span"}},
+		{name: "all", names: []string{domain.AllAttributes}, want: []string{"retries", "trace.id", "trace.span"}},
+		{name: "invalid name", names: []string{"trace.*.*"}, wantErr: domain.ErrInvalidAttributeName},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			svc := newTestService(t)
+			createQueue(t, svc, "queue", domain.QueueAttributes{})
+			if _, err := svc.SendMessage(ctx, "queue", domain.OutgoingMessage{Body: []byte("body"), Attributes: attrs}); err != nil {
+				t.Fatalf("SendMessage() error = %v", err)
+			}
+
+			noWait := time.Duration(0)
+			messages, err := svc.ReceiveMessage(ctx, "queue", domain.ReceiveOptions{WaitTime: &noWait, MessageAttributeNames: tt.names})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ReceiveMessage() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			got := make([]string, 0)
+			for name, attr := range messages[0].Attributes {
+				if want := attrs[name]; attr.DataType != want.DataType || attr.StringValue != want.StringValue {
+					t.Errorf("attribute %s = %+v, want %+v", name, attr, want)
+				}
+				got = append(got, name)
+			}
+			sort.Strings(got)
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("returned attributes %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReceiveSystemAttributeNames(t *testing.T) {
+	ctx := context.Background()
+	svc := newTestService(t)
+	createQueue(t, svc, "queue", domain.QueueAttributes{})
+
+	noWait := time.Duration(0)
+	_, err := svc.ReceiveMessage(ctx, "queue", domain.ReceiveOptions{WaitTime: &noWait, AttributeNames: []string{"SenderId"}})
+	if !errors.Is(err, domain.ErrInvalidAttributeName) {
+		t.Errorf("ReceiveMessage() error = %v, want %v", err, domain.ErrInvalidAttributeName)
+	}
+}
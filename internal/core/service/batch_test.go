@@ -0,0 +1,131 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"queueserver/internal/core/domain"
+)
+
+// sendEntries returns count entries with the ids e0, e1, ...
+func sendEntries(count int) []domain.SendEntry {
+	entries := make([]domain.SendEntry, 0, count)
+	for i := 0; i < count; i++ {
+		entries = append(entries, domain.SendEntry{
+			ID:              fmt.Sprintf("e%d", i),
+			OutgoingMessage: domain.OutgoingMessage{Body: []byte(fmt.Sprintf("body %d", i))},
+		})
+	}
+	return entries
+}
+
+func TestSendMessageBatch(t *testing.T) {
+	maxSize := domain.MinMaximumMessageSize
+	tooLarge := sendEntries(3)
+	tooLarge[1].Body = []byte(strings.Repeat("x", maxSize+1))
+
+	tests := []struct {
+		name       string
+		entries    []domain.SendEntry
+		wantErr    error
+		wantSent   []string // entry ids
+		wantFailed []string // entry ids
+	}{
+		{name: "ten entries", entries: sendEntries(10), wantSent: []string{"e0", "e1", "e2", "e3", "e4", "e5", "e6", "e7", "e8", "e9"}},
+		{name: "eleven entries", entries: sendEntries(11), wantErr: domain.ErrTooManyBatchEntries},
+		{name: "no entries", wantErr: domain.ErrEmptyBatchRequest},
+		{name: "duplicate entry ids", entries: append(sendEntries(2), sendEntries(1)...), wantErr: domain.ErrBatchEntryIDsNotUnique},
+		{name: "invalid entry id", entries: []domain.SendEntry{{ID: "e 0"}}, wantErr: domain.ErrInvalidBatchEntryID},
+		{name: "one entry too large", entries: tooLarge, wantSent: []string{"e0", "e2"}, wantFailed: []string{"e1"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			svc := newTestService(t)
+			createQueue(t, svc, "queue", domain.QueueAttributes{MaximumMessageSize: &maxSize})
+
+			sent, failed, err := svc.SendMessageBatch(ctx, "queue", tt.entries)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("SendMessageBatch() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				if _, stats, _ := svc.GetQueueAttributes(ctx, "queue"); stats.Visible != 0 {
+					t.Errorf("a rejected batch stored %d messages", stats.Visible)
+				}
+				return
+			}
+
+			sentIDs := make([]string, 0, len(sent))
+			for _, result := range sent {
+				sentIDs = append(sentIDs, result.ID)
+			}
+			if fmt.Sprint(sentIDs) != fmt.Sprint(tt.wantSent) {
+				t.Errorf("sent entries = %v, want %v", sentIDs, tt.wantSent)
+			}
+			failedIDs := make([]string, 0, len(failed))
+			for _, entry := range failed {
+				if !errors.Is(entry.Err, domain.ErrMessageTooLarge) {
+					t.Errorf("entry %s failed with %v, want %v", entry.ID, entry.Err, domain.ErrMessageTooLarge)
+				}
+				failedIDs = append(failedIDs, entry.ID)
+			}
+			if fmt.Sprint(failedIDs) != fmt.Sprint(tt.wantFailed) {
+				t.Errorf("failed entries = %v, want %v", failedIDs, tt.wantFailed)
+			}
+
+			// Only the successful entries are stored
+			if got := receive(t, svc, "queue", domain.MaxBatchEntries); len(got) != len(tt.wantSent) {
+				t.Errorf("the queue holds %d messages, want %d", len(got), len(tt.wantSent))
+			}
+		})
+	}
+}
+
+func TestDeleteMessageBatch(t *testing.T) {
+	ctx := context.Background()
+	svc := newTestService(t)
+	createQueue(t, svc, "queue", domain.QueueAttributes{})
+	if _, _, err := svc.SendMessageBatch(ctx, "queue", sendEntries(2)); err != nil {
+		t.Fatalf("SendMessageBatch() error = %v", err)
+	}
+	messages := receive(t, svc, "queue", domain.MaxBatchEntries)
+	if len(messages) != 2 {
+		t.Fatalf("received %d messages, want 2", len(messages))
+	}
+
+	tooMany := make([]domain.DeleteEntry, 0, domain.MaxBatchEntries+1)
+	for i := 0; i <= domain.MaxBatchEntries; i++ {
+		tooMany = append(tooMany, domain.DeleteEntry{ID: fmt.Sprintf("d%d", i), ReceiptHandle: messages[0].ReceiptHandle})
+	}
+	duplicates := []domain.DeleteEntry{
+		{ID: "d0", ReceiptHandle: messages[0].ReceiptHandle},
+		{ID: "d0", ReceiptHandle: messages[1].ReceiptHandle},
+	}
+	for name, entries := range map[string][]domain.DeleteEntry{"eleven entries": tooMany, "duplicate entry ids": duplicates} {
+		if _, _, err := svc.DeleteMessageBatch(ctx, "queue", entries); err == nil {
+			t.Fatalf("DeleteMessageBatch() with %s succeeded, want an error", name)
+		}
+	}
+
+	deleted, failed, err := svc.DeleteMessageBatch(ctx, "queue", []domain.DeleteEntry{
+		{ID: "d0", ReceiptHandle: messages[0].ReceiptHandle},
+		{ID: "d1", ReceiptHandle: "unknown"},
+		{ID: "d2", ReceiptHandle: messages[1].ReceiptHandle},
+	})
+	if err != nil {
+		t.Fatalf("DeleteMessageBatch() error = %v", err)
+	}
+	if fmt.Sprint(deleted) != "[d0 d2]" {
+		t.Errorf("deleted entries = %v, want [d0 d2]", deleted)
+	}
+	if len(failed) != 1 || failed[0].ID != "d1" || !errors.Is(failed[0].Err, domain.ErrReceiptHandleNotFound) {
+		t.Errorf("failed entries = %v, want d1 with %v", failed, domain.ErrReceiptHandleNotFound)
+	}
+
+	if _, stats, _ := svc.GetQueueAttributes(ctx, "queue"); stats.Visible+stats.NotVisible != 0 {
+		t.Errorf("the queue still holds %d messages", stats.Visible+stats.NotVisible)
+	}
+}
@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"queueserver/internal/core/domain"
+	"queueserver/internal/core/port/repository"
+)
+
+// Settings of the sweep collecting the blobs left behind by deleted queues
+// and failed sends
+const (
+	blobSweepInterval = time.Hour
+	blobSweepGrace    = 10 * time.Minute // younger blobs may belong to a send in progress
+	blobSweepBatch    = 500              // blob keys looked up per query
+)
+
+// blobs offloads the message bodies larger than threshold bytes to store
+type blobs struct {
+	store     repository.BlobStore
+	threshold int
+}
+
+// WithBlobStore offloads the bodies larger than threshold bytes to store. The
+// message keeps a reference to its body, which is loaded back on receive and
+// deleted along with the message.
+func WithBlobStore(store repository.BlobStore, threshold int) Option {
+	return func(q *queueService) {
+		q.blobs = &blobs{store: store, threshold: threshold}
+	}
+}
+
+// offloadBodies moves the large bodies of new messages to the blob store,
+// under the ID of their message and compressed with the codec of their queue
+func (q *queueService) offloadBodies(ctx context.Context, messages []*domain.Message) error {
+	if q.blobs == nil {
+		return nil
+	}
+	for _, message := range messages {
+		if len(message.Body) <= q.blobs.threshold {
+			continue
+		}
+		compression, err := q.blobs.store.Put(ctx, message.ID, message.Body, message.Compression)
+		if err != nil {
+			q.releaseBlobs(ctx, messages, false)
+			return fmt.Errorf("failed to offload message body: %v", err)
+		}
+		message.BlobKey = message.ID
+		message.Compression = compression
+		message.Body = []byte{} // a nil body would be stored as NULL
+	}
+	return nil
+}
+
+// releaseBlobs deletes the offloaded bodies of new messages that were not
+// enqueued: all of them unless saved, else those deduplicated, which the
+// repository gave the ID of the original message
+func (q *queueService) releaseBlobs(ctx context.Context, messages []*domain.Message, saved bool) {
+	keys := make([]string, 0)
+	for _, message := range messages {
+		if message.BlobKey != "" && (!saved || message.ID != message.BlobKey) {
+			keys = append(keys, message.BlobKey)
+		}
+	}
+	q.deleteBlobs(ctx, keys)
+}
+
+// loadBodies reads the offloaded bodies of received messages back
+func (q *queueService) loadBodies(ctx context.Context, messages []*domain.Message) error {
+	for _, message := range messages {
+		if message.BlobKey == "" {
+			continue
+		}
+		if q.blobs == nil {
+			return fmt.Errorf("failed to load the body of message %s: no blob store is configured", message.ID)
+		}
+		body, err := q.blobs.store.Get(ctx, message.BlobKey, message.Compression)
+		if err != nil {
+			return fmt.Errorf("failed to load the body of message %s: %w", message.ID, err)
+		}
+		message.Body = body
+	}
+	return nil
+}
+
+// deleteBlobs deletes the blobs stored under keys. The messages are already
+// gone, so failures are only logged and left to the sweep.
+func (q *queueService) deleteBlobs(ctx context.Context, keys []string) {
+	if q.blobs == nil || len(keys) == 0 {
+		return
+	}
+	if err := q.blobs.store.Delete(ctx, keys); err != nil {
+		log.Printf("blob store: %v", err)
+	}
+}
+
+// sweepBlobs deletes the blobs stored before now-blobSweepGrace whose message
+// no longer references them, blobSweepBatch keys at a time. A batch
+// that fails is left to the next sweep rather than aborting this one.
+func (q *queueService) sweepBlobs(ctx context.Context, now time.Time) error {
+	keys, err := q.blobs.store.List(ctx, now.Add(-blobSweepGrace))
+	if err != nil {
+		return err
+	}
+
+	var lastErr error
+	failed := 0
+	for start := 0; start < len(keys); start += blobSweepBatch {
+		batch := keys[start:min(start+blobSweepBatch, len(keys))]
+		if err := q.sweepBlobBatch(ctx, batch); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			lastErr = err
+			failed += len(batch)
+		}
+	}
+	if lastErr != nil {
+		return fmt.Errorf("failed to sweep %d of %d blobs: %v", failed, len(keys), lastErr)
+	}
+	return nil
+}
+
+// sweepBlobBatch deletes those of keys no message references
+func (q *queueService) sweepBlobBatch(ctx context.Context, keys []string) error {
+	referenced, err := q.messageRepos.ReferencedBlobKeys(ctx, keys)
+	if err != nil {
+		return err
+	}
+	inUse := make(map[string]bool, len(referenced))
+	for _, key := range referenced {
+		inUse[key] = true
+	}
+
+	orphans := make([]string, 0, len(keys)-len(referenced))
+	for _, key := range keys {
+		if !inUse[key] {
+			orphans = append(orphans, key)
+		}
+	}
+	if len(orphans) == 0 {
+		return nil
+	}
+	return q.blobs.store.Delete(ctx, orphans)
+}
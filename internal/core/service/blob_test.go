@@ -0,0 +1,190 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"queueserver/internal/adapter/config"
+	"queueserver/internal/adapter/repository"
+	"queueserver/internal/core/domain"
+	portRepository "queueserver/internal/core/port/repository"
+)
+
+const testBlobThreshold = 8
+
+// newBlobTestService returns a queue service over the memory repositories
+// that offloads the bodies larger than testBlobThreshold to a temporary
+// directory
+func newBlobTestService(t *testing.T, wrap func(portRepository.MessageRepository) portRepository.MessageRepository) (*queueService, *repository.MemoryMessageRepository, *repository.FilesystemBlobStore) {
+	t.Helper()
+	blobStore, err := repository.NewFilesystemBlobStore(&config.Config{BlobStorePath: t.TempDir()})
+	if err != nil {
+		t.Fatalf("NewFilesystemBlobStore() error = %v", err)
+	}
+	queueRepo := repository.NewMemoryQueueRepository()
+	messageRepo := repository.NewMemoryMessageRepository(queueRepo)
+	var repo portRepository.MessageRepository = messageRepo
+	if wrap != nil {
+		repo = wrap(messageRepo)
+	}
+	svc := NewQueueService(queueRepo, repo, repository.NewMemoryScheduleRepository(messageRepo),
+		WithBlobStore(blobStore, testBlobThreshold))
+	t.Cleanup(func() { svc.Close() })
+
+	if _, err := svc.CreateQueue(context.Background(), "queue", domain.QueueAttributes{}); err != nil {
+		t.Fatalf("CreateQueue() error = %v", err)
+	}
+	return svc.(*queueService), messageRepo, blobStore
+}
+
+func TestBlobOffload(t *testing.T) {
+	tests := []struct {
+		name      string
+		body      string
+		offloaded bool
+	}{
+		{name: "at the threshold", body: "12345678"},
+		{name: "over the threshold", body: "123456789", offloaded: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			svc, messageRepo, blobStore := newBlobTestService(t, nil)
+
+			id, err := svc.SendMessage(ctx, "queue", domain.OutgoingMessage{Body: []byte(tt.body)})
+			if err != nil {
+				t.Fatalf("SendMessage() error = %v", err)
+			}
+			stored, err := messageRepo.GetByMessageID(ctx, id)
+			if err != nil || stored == nil {
+				t.Fatalf("GetByMessageID() = %v, %v", stored, err)
+			}
+			if tt.offloaded {
+				if stored.BlobKey != id || len(stored.Body) != 0 {
+					t.Fatalf("stored blob key %q and body %q, want %q and no body", stored.BlobKey, stored.Body, id)
+				}
+				blob, err := blobStore.Get(ctx, id, stored.Compression)
+				if err != nil || string(blob) != tt.body {
+					t.Fatalf("blob store Get() = %q, %v, want %q", blob, err, tt.body)
+				}
+			} else if stored.BlobKey != "" || string(stored.Body) != tt.body {
+				t.Fatalf("stored blob key %q and body %q, want the body inline", stored.BlobKey, stored.Body)
+			}
+
+			noWait := time.Duration(0)
+			messages, err := svc.ReceiveMessage(ctx, "queue", domain.ReceiveOptions{MaxMessages: 1, WaitTime: &noWait})
+			if err != nil || len(messages) != 1 || string(messages[0].Body) != tt.body {
+				t.Fatalf("ReceiveMessage() = %v, %v, want body %q", messages, err, tt.body)
+			}
+
+			if _, err := svc.DeleteMessage(ctx, "queue", messages[0].ReceiptHandle); err != nil {
+				t.Fatalf("DeleteMessage() error = %v", err)
+			}
+			if _, err := blobStore.Get(ctx, id, domain.CompressionNone); !errors.Is(err, domain.ErrBlobNotFound) {
+				t.Errorf("blob store Get() after delete error = %v, want %v", err, domain.ErrBlobNotFound)
+			}
+		})
+	}
+}
+
+func TestBlobOffloadMissingBlob(t *testing.T) {
+	ctx := context.Background()
+	svc, _, blobStore := newBlobTestService(t, nil)
+
+	id, err := svc.SendMessage(ctx, "queue", domain.OutgoingMessage{Body: []byte("offloaded body")})
+	if err != nil {
+		t.Fatalf("SendMessage() error = %v", err)
+	}
+	if err := blobStore.Delete(ctx, []string{id}); err != nil {
+		t.Fatalf("blob store Delete() error = %v", err)
+	}
+
+	noWait := time.Duration(0)
+	_, err = svc.ReceiveMessage(ctx, "queue", domain.ReceiveOptions{MaxMessages: 1, WaitTime: &noWait})
+	if !errors.Is(err, domain.ErrBlobNotFound) {
+		t.Fatalf("ReceiveMessage() error = %v, want %v", err, domain.ErrBlobNotFound)
+	}
+}
+
+// failingBlobLookups fails the first failures calls to ReferencedBlobKeys
+type failingBlobLookups struct {
+	portRepository.MessageRepository
+	failures int
+}
+
+func (r *failingBlobLookups) ReferencedBlobKeys(ctx context.Context, keys []string) ([]string, error) {
+	if r.failures > 0 {
+		r.failures--
+		return nil, errors.New("lookup failed")
+	}
+	return r.MessageRepository.ReferencedBlobKeys(ctx, keys)
+}
+
+func TestSweepBlobs(t *testing.T) {
+	tests := []struct {
+		name     string
+		failures int // failed lookups, one per batch
+		wantErr  bool
+	}{
+		{name: "all batches"},
+		{name: "failed batch skipped", failures: 1, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			svc, _, blobStore := newBlobTestService(t, func(repo portRepository.MessageRepository) portRepository.MessageRepository {
+				return &failingBlobLookups{MessageRepository: repo, failures: tt.failures}
+			})
+
+			live, err := svc.SendMessage(ctx, "queue", domain.OutgoingMessage{Body: []byte("offloaded body")})
+			if err != nil {
+				t.Fatalf("SendMessage() error = %v", err)
+			}
+			// More orphans than one lookup checks
+			for i := 0; i <= blobSweepBatch; i++ {
+				if _, err := blobStore.Put(ctx, fmt.Sprintf("orphan-%d", i), []byte("orphan"), domain.CompressionNone); err != nil {
+					t.Fatalf("blob store Put() error = %v", err)
+				}
+			}
+
+			// Blobs within the grace period are left alone
+			if err := svc.sweepBlobs(ctx, time.Now()); err != nil {
+				t.Fatalf("sweepBlobs() error = %v", err)
+			}
+			remaining, err := blobStore.List(ctx, time.Now().Add(time.Minute))
+			if err != nil {
+				t.Fatalf("blob store List() error = %v", err)
+			}
+			if len(remaining) != blobSweepBatch+2 {
+				t.Fatalf("%d blobs left after sweeping within the grace period, want %d", len(remaining), blobSweepBatch+2)
+			}
+
+			err = svc.sweepBlobs(ctx, time.Now().Add(blobSweepGrace+time.Minute))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("sweepBlobs() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			remaining, err = blobStore.List(ctx, time.Now().Add(time.Minute))
+			if err != nil {
+				t.Fatalf("blob store List() error = %v", err)
+			}
+			orphans, kept := 0, false
+			for _, key := range remaining {
+				if key == live {
+					kept = true
+				} else {
+					orphans++
+				}
+			}
+			if !kept {
+				t.Fatalf("blob of %s swept", live)
+			}
+			// A failed lookup keeps the orphans of its batch only
+			if tt.wantErr && (orphans == 0 || orphans > blobSweepBatch) || !tt.wantErr && orphans != 0 {
+				t.Fatalf("%d orphans left after the sweep", orphans)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"queueserver/internal/core/domain"
+)
+
+func TestLongPolling(t *testing.T) {
+	tests := []struct {
+		name      string
+		waitTime  time.Duration
+		sendAfter time.Duration // 0 sends nothing
+		wantBody  string
+		minTaken  time.Duration
+		maxTaken  time.Duration
+	}{
+		{
+			name:      "a send wakes the receiver",
+			waitTime:  10 * time.Second,
+			sendAfter: 100 * time.Millisecond,
+			wantBody:  "wake up",
+			minTaken:  100 * time.Millisecond,
+			maxTaken:  2 * time.Second,
+		},
+		{
+			name:     "the wait runs out",
+			waitTime: 200 * time.Millisecond,
+			minTaken: 200 * time.Millisecond,
+			maxTaken: 2 * time.Second,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			svc := newTestService(t)
+			createQueue(t, svc, "queue", domain.QueueAttributes{})
+
+			started := time.Now()
+			if tt.sendAfter > 0 {
+				timer := time.AfterFunc(tt.sendAfter, func() {
+					if _, err := svc.SendMessage(ctx, "queue", domain.OutgoingMessage{Body: []byte(tt.wantBody)}); err != nil {
+						t.Errorf("SendMessage() error = %v", err)
+					}
+				})
+				defer timer.Stop()
+			}
+
+			messages, err := svc.ReceiveMessage(ctx, "queue", domain.ReceiveOptions{WaitTime: &tt.waitTime})
+			taken := time.Since(started)
+
+			if tt.wantBody == "" {
+				if !errors.Is(err, domain.ErrNoMessageAvailable) {
+					t.Fatalf("ReceiveMessage() = %d messages, %v, want %v", len(messages), err, domain.ErrNoMessageAvailable)
+				}
+			} else if err != nil || len(messages) != 1 || string(messages[0].Body) != tt.wantBody {
+				t.Fatalf("ReceiveMessage() = %v, %v, want %q", bodies(messages), err, tt.wantBody)
+			}
+			if taken < tt.minTaken || taken > tt.maxTaken {
+				t.Errorf("ReceiveMessage() returned after %s, want between %s and %s", taken, tt.minTaken, tt.maxTaken)
+			}
+		})
+	}
+}
@@ -0,0 +1,261 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"queueserver/internal/core/domain"
+)
+
+// maxFinishedMoveTasks is how many finished tasks are kept per source queue
+// for ListMessageMoveTasks
+const maxFinishedMoveTasks = 10
+
+// moveTask is the running state of a domain.MoveTask
+type moveTask struct {
+	mu     sync.Mutex
+	task   domain.MoveTask
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// snapshot returns a copy of the task that is safe to hand out
+func (t *moveTask) snapshot() *domain.MoveTask {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	task := t.task
+	return &task
+}
+
+func (t *moveTask) moved() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.task.MovedCount++
+}
+
+func (t *moveTask) finish(status string, reason string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.task.Status = status
+	t.task.FailureReason = reason
+}
+
+// moveTasks tracks the message move tasks started on this server
+type moveTasks struct {
+	mu    sync.Mutex
+	tasks map[string]*moveTask // keyed by task handle
+	wg    sync.WaitGroup
+}
+
+func newMoveTasks() *moveTasks {
+	return &moveTasks{
+		tasks: make(map[string]*moveTask),
+	}
+}
+
+// StartMessageMoveTask starts moving the messages of sourceQueueName in the
+// background, at most messagesPerSecond per second. Messages go back to the
+// queue they were dead-lettered from, or to destinationQueueName when set.
+func (q *queueService) StartMessageMoveTask(ctx context.Context, sourceQueueName string, destinationQueueName string, messagesPerSecond int) (*domain.MoveTask, error) {
+	if messagesPerSecond == 0 {
+		messagesPerSecond = domain.DefaultMoveTaskMessagesPerSecond
+	}
+	if messagesPerSecond < 0 || messagesPerSecond > domain.MaxMoveTaskMessagesPerSecond {
+		return nil, fmt.Errorf("%w: messages per second must be between 1 and %d",
+			domain.ErrInvalidMoveTask, domain.MaxMoveTaskMessagesPerSecond)
+	}
+	if sourceQueueName == destinationQueueName {
+		return nil, fmt.Errorf("%w: source and destination queues must differ", domain.ErrInvalidMoveTask)
+	}
+
+	source, err := q.getQueue(ctx, sourceQueueName)
+	if err != nil {
+		return nil, err
+	}
+	if destinationQueueName != "" {
+		destination, err := q.getQueue(ctx, destinationQueueName)
+		if err != nil {
+			return nil, err
+		}
+		if destination.FifoQueue != source.FifoQueue {
+			return nil, fmt.Errorf("%w: source and destination queues must both be FIFO or standard queues", domain.ErrInvalidMoveTask)
+		}
+	}
+
+	toMove, err := q.messageRepos.CountMovable(ctx, sourceQueueName, destinationQueueName, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	q.moveTasks.mu.Lock()
+	defer q.moveTasks.mu.Unlock()
+
+	for _, running := range q.moveTasks.tasks {
+		if snapshot := running.snapshot(); snapshot.SourceQueueName == sourceQueueName && snapshot.Running() {
+			return nil, domain.ErrMoveTaskRunning
+		}
+	}
+	q.moveTasks.prune(sourceQueueName)
+
+	taskCtx, cancel := context.WithCancel(context.Background())
+	task := &moveTask{
+		task: domain.MoveTask{
+			Handle:               generateID(),
+			SourceQueueName:      sourceQueueName,
+			DestinationQueueName: destinationQueueName,
+			MessagesPerSecond:    messagesPerSecond,
+			Status:               domain.MoveTaskRunning,
+			ToMoveCount:          toMove,
+			StartedAt:            time.Now(),
+		},
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+	q.moveTasks.tasks[task.task.Handle] = task
+
+	q.moveTasks.wg.Add(1)
+	go func() {
+		defer q.moveTasks.wg.Done()
+		q.runMoveTask(taskCtx, task)
+	}()
+
+	return task.snapshot(), nil
+}
+
+// ListMessageMoveTasks returns the move tasks of sourceQueueName, newest first
+func (q *queueService) ListMessageMoveTasks(ctx context.Context, sourceQueueName string) ([]*domain.MoveTask, error) {
+	if _, err := q.getQueue(ctx, sourceQueueName); err != nil {
+		return nil, err
+	}
+
+	q.moveTasks.mu.Lock()
+	defer q.moveTasks.mu.Unlock()
+
+	return q.moveTasks.bySource(sourceQueueName), nil
+}
+
+// CancelMessageMoveTask stops a running move task and returns its final state.
+// Messages moved before the cancellation stay in their new queue.
+func (q *queueService) CancelMessageMoveTask(ctx context.Context, taskHandle string) (*domain.MoveTask, error) {
+	q.moveTasks.mu.Lock()
+	task, ok := q.moveTasks.tasks[taskHandle]
+	q.moveTasks.mu.Unlock()
+
+	if !ok {
+		return nil, domain.ErrMoveTaskNotFound
+	}
+	if !task.snapshot().Running() {
+		return nil, domain.ErrMoveTaskNotRunning
+	}
+
+	task.cancel()
+	select {
+	case <-task.done:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	return task.snapshot(), nil
+}
+
+// runMoveTask moves one message per tick until the source queue has no
+// visible message left to move or the task is cancelled
+func (q *queueService) runMoveTask(ctx context.Context, task *moveTask) {
+	defer close(task.done)
+	defer task.cancel()
+
+	settings := task.snapshot()
+	ticker := time.NewTicker(time.Second / time.Duration(settings.MessagesPerSecond))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			task.finish(domain.MoveTaskCancelled, "")
+			return
+		case <-ticker.C:
+		}
+
+		msg, err := q.messageRepos.MoveNext(ctx, settings.SourceQueueName, settings.DestinationQueueName, time.Now())
+		if err != nil {
+			if ctx.Err() != nil {
+				task.finish(domain.MoveTaskCancelled, "")
+			} else {
+				task.finish(domain.MoveTaskFailed, err.Error())
+			}
+			return
+		}
+		if msg == nil {
+			q.finishMoveTask(ctx, task)
+			return
+		}
+		q.notifier.notify(msg.QueueName)
+		task.moved()
+	}
+}
+
+// finishMoveTask completes a task that has nothing left to move. A
+// destination deleted while the task ran stops it early, which fails it.
+func (q *queueService) finishMoveTask(ctx context.Context, task *moveTask) {
+	destinationQueueName := task.snapshot().DestinationQueueName
+	if destinationQueueName != "" {
+		destination, err := q.queueRepo.GetByName(ctx, destinationQueueName)
+		if err != nil {
+			task.finish(domain.MoveTaskFailed, err.Error())
+			return
+		}
+		if destination == nil {
+			task.finish(domain.MoveTaskFailed, fmt.Sprintf("destination queue %s was deleted", destinationQueueName))
+			return
+		}
+	}
+	task.finish(domain.MoveTaskCompleted, "")
+}
+
+// Close cancels every running task and waits for them to stop
+func (m *moveTasks) Close() error {
+	m.mu.Lock()
+	for _, task := range m.tasks {
+		task.cancel()
+	}
+	m.mu.Unlock()
+
+	m.wg.Wait()
+	return nil
+}
+
+// bySource returns the tasks of a source queue, newest first. Callers must
+// hold m.mu.
+func (m *moveTasks) bySource(sourceQueueName string) []*domain.MoveTask {
+	tasks := make([]*domain.MoveTask, 0)
+	for _, task := range m.tasks {
+		if snapshot := task.snapshot(); snapshot.SourceQueueName == sourceQueueName {
+			tasks = append(tasks, snapshot)
+		}
+	}
+	sort.Slice(tasks, func(i, j int) bool {
+		return tasks[i].StartedAt.After(tasks[j].StartedAt)
+	})
+	return tasks
+}
+
+// prune forgets the oldest finished tasks of a source queue beyond
+// maxFinishedMoveTasks. Callers must hold m.mu.
+func (m *moveTasks) prune(sourceQueueName string) {
+	finished := 0
+	for _, task := range m.bySource(sourceQueueName) {
+		if task.Running() {
+			continue
+		}
+		finished++
+		if finished > maxFinishedMoveTasks {
+			delete(m.tasks, task.Handle)
+		}
+	}
+}
@@ -0,0 +1,243 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"queueserver/internal/core/domain"
+	"queueserver/internal/core/port/service"
+)
+
+// deadLetter sends one message to each source queue and receives it until it
+// is dead-lettered to dlq. Every source queue must redrive to dlq after one
+// receive with no visibility timeout.
+func deadLetter(t *testing.T, svc service.QueueService, sourceQueueNames ...string) {
+	t.Helper()
+	for _, name := range sourceQueueNames {
+		if _, err := svc.SendMessage(context.Background(), name, domain.OutgoingMessage{Body: []byte("from " + name)}); err != nil {
+			t.Fatalf("SendMessage(%s) error = %v", name, err)
+		}
+		if messages := receive(t, svc, name, 1); len(messages) != 1 {
+			t.Fatalf("receive from %s got %d messages, want 1", name, len(messages))
+		}
+		if messages := receive(t, svc, name, 1); len(messages) != 0 {
+			t.Fatalf("receive from %s got %d messages, want the message dead-lettered", name, len(messages))
+		}
+	}
+}
+
+// createDeadLetterQueues creates dlq and the named source queues redriving to it
+func createDeadLetterQueues(t *testing.T, svc service.QueueService, sourceQueueNames ...string) {
+	t.Helper()
+	noVisibility := time.Duration(0)
+	createQueue(t, svc, "dlq", domain.QueueAttributes{})
+	for _, name := range sourceQueueNames {
+		createQueue(t, svc, name, domain.QueueAttributes{
+			VisibilityTimeout: &noVisibility,
+			RedrivePolicy:     &domain.RedrivePolicy{DeadLetterQueueName: "dlq", MaxReceiveCount: 1},
+		})
+	}
+}
+
+// waitForMoveTask polls the tasks of sourceQueueName until the task stops running
+func waitForMoveTask(t *testing.T, svc service.QueueService, sourceQueueName string, handle string) *domain.MoveTask {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		tasks, err := svc.ListMessageMoveTasks(context.Background(), sourceQueueName)
+		if err != nil {
+			t.Fatalf("ListMessageMoveTasks() error = %v", err)
+		}
+		for _, task := range tasks {
+			if task.Handle == handle && !task.Running() {
+				return task
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("move task %s still running", handle)
+	return nil
+}
+
+func TestMessageMoveTask(t *testing.T) {
+	tests := []struct {
+		name        string
+		destination string
+		deleted     string // source queue deleted before the task starts
+		rate        int
+		wantMoved   map[string]int // messages per queue once the task completed
+	}{
+		{
+			name:      "back to the source queues",
+			rate:      20,
+			wantMoved: map[string]int{"a": 1, "b": 1, "c": 1, "dlq": 0},
+		},
+		{
+			name:        "to a destination queue",
+			destination: "a",
+			rate:        20,
+			wantMoved:   map[string]int{"a": 3, "b": 0, "c": 0, "dlq": 0},
+		},
+		{
+			name:      "skips deleted source queues",
+			deleted:   "b",
+			rate:      20,
+			wantMoved: map[string]int{"a": 1, "c": 1, "dlq": 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			svc := newTestService(t)
+			createDeadLetterQueues(t, svc, "a", "b", "c")
+			deadLetter(t, svc, "a", "b", "c")
+			if tt.deleted != "" {
+				if err := svc.DeleteQueue(ctx, tt.deleted); err != nil {
+					t.Fatalf("DeleteQueue() error = %v", err)
+				}
+			}
+
+			wantTotal := int64(0)
+			for name, count := range tt.wantMoved {
+				if name != "dlq" {
+					wantTotal += int64(count)
+				}
+			}
+
+			started := time.Now()
+			task, err := svc.StartMessageMoveTask(ctx, "dlq", tt.destination, tt.rate)
+			if err != nil {
+				t.Fatalf("StartMessageMoveTask() error = %v", err)
+			}
+			if task.ToMoveCount != wantTotal {
+				t.Errorf("ToMoveCount = %d, want %d", task.ToMoveCount, wantTotal)
+			}
+
+			task = waitForMoveTask(t, svc, "dlq", task.Handle)
+			// One message moves per tick
+			if elapsed, minimum := time.Since(started), time.Duration(wantTotal)*time.Second/time.Duration(tt.rate); elapsed < minimum {
+				t.Errorf("the task took %s, want at least %s at %d messages per second", elapsed, minimum, tt.rate)
+			}
+			if task.Status != domain.MoveTaskCompleted || task.MovedCount != wantTotal {
+				t.Fatalf("task = %s with %d moved, want %s with %d moved", task.Status, task.MovedCount, domain.MoveTaskCompleted, wantTotal)
+			}
+
+			for name, want := range tt.wantMoved {
+				_, stats, err := svc.GetQueueAttributes(ctx, name)
+				if err != nil {
+					t.Fatalf("GetQueueAttributes(%s) error = %v", name, err)
+				}
+				if stats.Visible != int64(want) {
+					t.Errorf("%s holds %d visible messages, want %d", name, stats.Visible, want)
+				}
+			}
+			// Moved messages start over in their new queue
+			for name, want := range tt.wantMoved {
+				if name == "dlq" || want == 0 {
+					continue
+				}
+				for _, message := range receive(t, svc, name, domain.MaxBatchEntries) {
+					if message.SourceQueueName != "" || message.ReceiveCount != 1 {
+						t.Errorf("moved message in %s = %+v, want it received once with no source queue", name, message)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestCancelMessageMoveTask(t *testing.T) {
+	ctx := context.Background()
+	svc := newTestService(t)
+	createDeadLetterQueues(t, svc, "a", "b")
+	deadLetter(t, svc, "a", "b")
+
+	task, err := svc.StartMessageMoveTask(ctx, "dlq", "", 1)
+	if err != nil {
+		t.Fatalf("StartMessageMoveTask() error = %v", err)
+	}
+	if _, err := svc.StartMessageMoveTask(ctx, "dlq", "", 1); !errors.Is(err, domain.ErrMoveTaskRunning) {
+		t.Fatalf("second StartMessageMoveTask() error = %v, want %v", err, domain.ErrMoveTaskRunning)
+	}
+
+	// The first message only moves after a second
+	cancelled, err := svc.CancelMessageMoveTask(ctx, task.Handle)
+	if err != nil {
+		t.Fatalf("CancelMessageMoveTask() error = %v", err)
+	}
+	if cancelled.Status != domain.MoveTaskCancelled || cancelled.MovedCount != 0 {
+		t.Errorf("cancelled task = %s with %d moved, want %s with none moved", cancelled.Status, cancelled.MovedCount, domain.MoveTaskCancelled)
+	}
+
+	if _, err := svc.CancelMessageMoveTask(ctx, task.Handle); !errors.Is(err, domain.ErrMoveTaskNotRunning) {
+		t.Errorf("second CancelMessageMoveTask() error = %v, want %v", err, domain.ErrMoveTaskNotRunning)
+	}
+	if _, err := svc.CancelMessageMoveTask(ctx, "unknown"); !errors.Is(err, domain.ErrMoveTaskNotFound) {
+		t.Errorf("CancelMessageMoveTask(unknown) error = %v, want %v", err, domain.ErrMoveTaskNotFound)
+	}
+}
+
+func TestMessageMoveTaskPruning(t *testing.T) {
+	ctx := context.Background()
+	svc := newTestService(t)
+	createQueue(t, svc, "dlq", domain.QueueAttributes{})
+
+	// Tasks on an empty queue complete on their first tick
+	handles := make([]string, 0)
+	for i := 0; i < 15; i++ {
+		task, err := svc.StartMessageMoveTask(ctx, "dlq", "", domain.MaxMoveTaskMessagesPerSecond)
+		if err != nil {
+			t.Fatalf("StartMessageMoveTask() error = %v", err)
+		}
+		waitForMoveTask(t, svc, "dlq", task.Handle)
+		handles = append(handles, task.Handle)
+	}
+
+	tasks, err := svc.ListMessageMoveTasks(ctx, "dlq")
+	if err != nil {
+		t.Fatalf("ListMessageMoveTasks() error = %v", err)
+	}
+	// Starting a task keeps the ten newest finished ones
+	if len(tasks) != 11 {
+		t.Fatalf("listed %d tasks, want 11", len(tasks))
+	}
+	for i, task := range tasks {
+		if want := handles[len(handles)-1-i]; task.Handle != want {
+			t.Errorf("task %d = %s, want %s", i, task.Handle, want)
+		}
+	}
+}
+
+func TestMessageMoveTaskRenumbers(t *testing.T) {
+	ctx := context.Background()
+	svc := newTestService(t)
+	fifo := true
+	createQueue(t, svc, "dlq", domain.QueueAttributes{FifoQueue: &fifo})
+	createQueue(t, svc, "fifo", domain.QueueAttributes{FifoQueue: &fifo})
+
+	// "old" is sent first, but the move appends it to its group behind "new"
+	for _, send := range []struct{ queueName, body string }{{"dlq", "old"}, {"fifo", "new"}} {
+		if _, err := svc.SendMessage(ctx, send.queueName, domain.OutgoingMessage{Body: []byte(send.body), MessageGroupID: "group"}); err != nil {
+			t.Fatalf("SendMessage() error = %v", err)
+		}
+	}
+	task, err := svc.StartMessageMoveTask(ctx, "dlq", "fifo", domain.MaxMoveTaskMessagesPerSecond)
+	if err != nil {
+		t.Fatalf("StartMessageMoveTask() error = %v", err)
+	}
+	if task = waitForMoveTask(t, svc, "dlq", task.Handle); task.MovedCount != 1 {
+		t.Fatalf("the task moved %d messages, want 1", task.MovedCount)
+	}
+
+	for _, want := range []string{"new", "old"} {
+		messages := receive(t, svc, "fifo", domain.MaxBatchEntries)
+		if len(messages) != 1 || string(messages[0].Body) != want {
+			t.Fatalf("receive = %v, want [%s]", bodies(messages), want)
+		}
+		if _, err := svc.DeleteMessage(ctx, "fifo", messages[0].ReceiptHandle); err != nil {
+			t.Fatalf("DeleteMessage() error = %v", err)
+		}
+	}
+}
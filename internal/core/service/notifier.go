@@ -0,0 +1,43 @@
+package service
+
+import "sync"
+
+// notifier wakes the receives of this server that wait for a queue to get
+// visible messages. Every waiter of a queue shares one channel, which is
+// closed and replaced on each notification.
+type notifier struct {
+	mu       sync.Mutex
+	channels map[string]chan struct{} // keyed by queue name
+}
+
+func newNotifier() *notifier {
+	return &notifier{
+		channels: make(map[string]chan struct{}),
+	}
+}
+
+// wait returns a channel that is closed by the next notification of
+// queueName. Callers must get it before looking for messages, so that a
+// message sent in between is not missed.
+func (n *notifier) wait(queueName string) <-chan struct{} {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	ch, ok := n.channels[queueName]
+	if !ok {
+		ch = make(chan struct{})
+		n.channels[queueName] = ch
+	}
+	return ch
+}
+
+// notify wakes every receive waiting on queueName
+func (n *notifier) notify(queueName string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if ch, ok := n.channels[queueName]; ok {
+		close(ch)
+		delete(n.channels, queueName)
+	}
+}
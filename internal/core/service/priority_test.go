@@ -0,0 +1,95 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"queueserver/internal/core/domain"
+)
+
+func TestPriorityAging(t *testing.T) {
+	aging := domain.MinPriorityAging
+	priority := func(p int) *int { return &p }
+
+	tests := []struct {
+		name  string
+		sends []domain.OutgoingMessage
+		pause time.Duration // before the last send
+		want  []string
+	}{
+		{
+			name:  "higher priority first",
+			sends: []domain.OutgoingMessage{{Body: []byte("low")}, {Body: []byte("high"), Priority: priority(5)}},
+			want:  []string{"high", "low"},
+		},
+		{
+			name:  "same priority in send order",
+			sends: []domain.OutgoingMessage{{Body: []byte("first"), Priority: priority(3)}, {Body: []byte("second"), Priority: priority(3)}},
+			want:  []string{"first", "second"},
+		},
+		{
+			name:  "aged message overtakes a higher priority",
+			sends: []domain.OutgoingMessage{{Body: []byte("low")}, {Body: []byte("high"), Priority: priority(1)}},
+			pause: aging + 100*time.Millisecond,
+			want:  []string{"low", "high"},
+		},
+		{
+			name:  "aging by less than the priority gap",
+			sends: []domain.OutgoingMessage{{Body: []byte("low")}, {Body: []byte("high"), Priority: priority(2)}},
+			pause: aging + 100*time.Millisecond,
+			want:  []string{"high", "low"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			svc := newTestService(t)
+			enabled := true
+			createQueue(t, svc, "queue", domain.QueueAttributes{PriorityQueue: &enabled, PriorityAging: &aging})
+
+			for i, outgoing := range tt.sends {
+				if i == len(tt.sends)-1 {
+					time.Sleep(tt.pause)
+				}
+				if _, err := svc.SendMessage(ctx, "queue", outgoing); err != nil {
+					t.Fatalf("SendMessage() error = %v", err)
+				}
+			}
+
+			if got := bodies(receive(t, svc, "queue", domain.MaxBatchEntries)); fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("received %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPriorityValidation(t *testing.T) {
+	ctx := context.Background()
+	svc := newTestService(t)
+	enabled := true
+	createQueue(t, svc, "priority", domain.QueueAttributes{PriorityQueue: &enabled})
+	createQueue(t, svc, "standard", domain.QueueAttributes{})
+
+	tests := []struct {
+		name      string
+		queueName string
+		priority  int
+		wantErr   error
+	}{
+		{name: "highest priority", queueName: "priority", priority: domain.MaxPriority},
+		{name: "above the highest priority", queueName: "priority", priority: domain.MaxPriority + 1, wantErr: domain.ErrInvalidPriority},
+		{name: "negative priority", queueName: "priority", priority: -1, wantErr: domain.ErrInvalidPriority},
+		{name: "standard queue", queueName: "standard", priority: 1, wantErr: domain.ErrInvalidPriority},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.SendMessage(ctx, tt.queueName, domain.OutgoingMessage{Body: []byte("body"), Priority: &tt.priority})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("SendMessage() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
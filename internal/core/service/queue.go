@@ -2,90 +2,725 @@ package service
 
 import (
 	"context"
-	"errors"
-	"sync"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
 	"time"
 
-	"queueserver/internal/adapter/repository"
 	"queueserver/internal/core/domain"
+	"queueserver/internal/core/port/repository"
 	"queueserver/internal/core/port/service"
 
 	"github.com/google/uuid"
 )
 
+// Page size limits for ListQueues
+const (
+	defaultListQueuesMaxResults = 1000
+	maxListQueuesMaxResults     = 1000
+)
+
+// queueService keeps no message state of its own: every operation goes
+// through the repositories, so several replicas can share one database
 type queueService struct {
-	messages     []*domain.Message
-	queueRepo    *repository.PostgresQueueRepository
-	messageRepos *repository.PostgresMessageRepository
-	mu           sync.Mutex // for thread-safe access
+	queueRepo    repository.QueueRepository
+	messageRepos repository.MessageRepository
+	scheduleRepo repository.ScheduleRepository
+	moveTasks    *moveTasks
+	notifier     *notifier
+	scheduler    *scheduler
+	reaper       *reaper
+	listener     func(domain.LifecycleEvent) // nil when nobody listens
+	blobs        *blobs                      // nil when bodies are never offloaded
 }
 
-func NewQueueService(queueRepo *repository.PostgresQueueRepository, messageRepo *repository.PostgresMessageRepository) service.QueueService {
-	return &queueService{
-		messages:     make([]*domain.Message, 0),
+// Option configures an optional feature of the queue service
+type Option func(*queueService)
+
+// WithLifecycleListener calls listener with every lifecycle event, such as the
+// expiry of a message. It is called from background goroutines and must not
+// block.
+func WithLifecycleListener(listener func(domain.LifecycleEvent)) Option {
+	return func(q *queueService) {
+		q.listener = listener
+	}
+}
+
+// NewQueueService creates the service and starts firing the due schedules
+// and deleting the expired messages in the background until it is closed
+func NewQueueService(queueRepo repository.QueueRepository, messageRepo repository.MessageRepository, scheduleRepo repository.ScheduleRepository, opts ...Option) service.QueueService {
+	q := &queueService{
 		queueRepo:    queueRepo,
 		messageRepos: messageRepo,
+		scheduleRepo: scheduleRepo,
+		moveTasks:    newMoveTasks(),
+		notifier:     newNotifier(),
+	}
+	for _, opt := range opts {
+		opt(q)
 	}
+	q.scheduler = q.startScheduler()
+	q.reaper = q.startReaper()
+	return q
 }
 
-// SendMessage pushes a message onto the queue
-func (q *queueService) SendMessage(ctx context.Context, queueName string, body string) (string, error) {
-	q.mu.Lock()
-	defer q.mu.Unlock()
+// Close stops the background work of the service
+func (q *queueService) Close() error {
+	q.scheduler.Close()
+	q.reaper.Close()
+	return q.moveTasks.Close()
+}
 
-	message := &domain.Message{
-		ID:                generateID(),
-		Body:              body,
-		ReceiptHandle:     generateReceiptHandle(),
-		QueueName:         queueName,
-		VisibilityTimeout: time.Now(), // Initial visibility timeout set to now
+// emit reports a lifecycle event to the listener, if any
+func (q *queueService) emit(event domain.LifecycleEvent) {
+	if q.listener != nil {
+		q.listener(event)
+	}
+}
+
+// CreateQueue registers a new queue
+func (q *queueService) CreateQueue(ctx context.Context, queueName string, attrs domain.QueueAttributes) (*domain.Queue, error) {
+	if err := domain.ValidateQueueName(queueName); err != nil {
+		return nil, err
+	}
+
+	existing, err := q.queueRepo.GetByName(ctx, queueName)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, domain.ErrQueueAlreadyExists
+	}
+
+	queue := domain.NewQueue(queueName)
+	if err := q.validateMaximumMessageSize(attrs); err != nil {
+		return nil, err
+	}
+	if err := queue.Apply(attrs); err != nil {
+		return nil, err
+	}
+	if err := q.validateRedrivePolicy(ctx, queue); err != nil {
+		return nil, err
+	}
+	if err := q.queueRepo.Save(ctx, queue); err != nil {
+		return nil, err
+	}
+
+	return queue, nil
+}
+
+// DeleteQueue removes a queue together with all of its messages and schedules
+func (q *queueService) DeleteQueue(ctx context.Context, queueName string) error {
+	if _, err := q.getQueue(ctx, queueName); err != nil {
+		return err
+	}
+
+	if err := q.scheduleRepo.DeleteByQueueName(ctx, queueName); err != nil {
+		return err
+	}
+	if err := q.messageRepos.DeleteByQueueName(ctx, queueName); err != nil {
+		return err
+	}
+	if err := q.queueRepo.Delete(ctx, queueName); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ListQueues returns one page of queues whose name starts with prefix and the
+// token for the following page, which is empty on the last page
+func (q *queueService) ListQueues(ctx context.Context, prefix string, maxResults int, nextToken string) ([]*domain.Queue, string, error) {
+	if maxResults <= 0 {
+		maxResults = defaultListQueuesMaxResults
+	}
+	if maxResults > maxListQueuesMaxResults {
+		maxResults = maxListQueuesMaxResults
+	}
+
+	startAfter := ""
+	if nextToken != "" {
+		decoded, err := base64.RawURLEncoding.DecodeString(nextToken)
+		if err != nil {
+			return nil, "", domain.ErrInvalidNextToken
+		}
+		startAfter = string(decoded)
+	}
+
+	// Fetch one extra row to find out whether there is another page
+	queues, err := q.queueRepo.List(ctx, prefix, startAfter, maxResults+1)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(queues) <= maxResults {
+		return queues, "", nil
+	}
+
+	queues = queues[:maxResults]
+	last := queues[len(queues)-1].Name
+	return queues, base64.RawURLEncoding.EncodeToString([]byte(last)), nil
+}
+
+// GetQueueAttributes returns a queue along with its approximate message counters
+func (q *queueService) GetQueueAttributes(ctx context.Context, queueName string) (*domain.Queue, *domain.QueueStats, error) {
+	queue, err := q.getQueue(ctx, queueName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stats, err := q.messageRepos.CountByQueue(ctx, queueName, time.Now())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return queue, &stats, nil
+}
+
+// SetQueueAttributes updates the configurable attributes of a queue
+func (q *queueService) SetQueueAttributes(ctx context.Context, queueName string, attrs domain.QueueAttributes) (*domain.Queue, error) {
+	queue, err := q.getQueue(ctx, queueName)
+	if err != nil {
+		return nil, err
+	}
+	if attrs.FifoQueue != nil && *attrs.FifoQueue != queue.FifoQueue {
+		return nil, fmt.Errorf("%w: FifoQueue cannot be changed once the queue is created", domain.ErrInvalidQueueAttribute)
+	}
+	if err := q.validateMaximumMessageSize(attrs); err != nil {
+		return nil, err
+	}
+
+	if err := queue.Apply(attrs); err != nil {
+		return nil, err
+	}
+	if err := q.validateRedrivePolicy(ctx, queue); err != nil {
+		return nil, err
+	}
+	if err := q.queueRepo.Update(ctx, queue); err != nil {
+		return nil, err
 	}
 
-	q.messages = append(q.messages, message)
+	return queue, nil
+}
 
-	err := q.messageRepos.Save(ctx, message)
+// SendMessage pushes a message onto the queue. A repeat of a deduplication id
+// within the deduplication window of the queue is not enqueued again, the ID
+// of the original message is returned instead.
+func (q *queueService) SendMessage(ctx context.Context, queueName string, outgoing domain.OutgoingMessage) (string, error) {
+	queue, err := q.getQueue(ctx, queueName)
 	if err != nil {
-		return "", errors.New("save_message: error to save the message on postgres")
+		return "", err
+	}
+	if err := validateOutgoingMessage(queue, outgoing); err != nil {
+		return "", err
 	}
 
+	messages := []*domain.Message{newMessage(queue, outgoing, time.Now())}
+	if err := q.offloadBodies(ctx, messages); err != nil {
+		return "", err
+	}
+	err = q.messageRepos.SaveBatch(ctx, messages)
+	if err != nil {
+		q.releaseBlobs(ctx, messages, false)
+		return "", fmt.Errorf("save_message: error to save the message: %w", err)
+	}
+	q.releaseBlobs(ctx, messages, true)
+	q.notifier.notify(queueName)
+
+	message := messages[0]
 	return message.ID, nil
 }
 
-// ReceiveMessage retrieves a message from the queue with a visibility timeout
-func (q *queueService) ReceiveMessage(ctx context.Context, queueName string, timeout time.Duration) (*domain.Message, error) {
-	q.mu.Lock()
-	defer q.mu.Unlock()
+// SendMessageBatch pushes up to domain.MaxBatchEntries messages onto the queue
+// with a single write, in the order of the entries. Invalid entries fail on
+// their own without failing the others, and repeated deduplication ids get
+// the ID of the original message.
+func (q *queueService) SendMessageBatch(ctx context.Context, queueName string, entries []domain.SendEntry) ([]domain.SendResult, []domain.BatchEntryError, error) {
+	ids := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		ids = append(ids, entry.ID)
+	}
+	if err := domain.ValidateBatchEntryIDs(ids); err != nil {
+		return nil, nil, err
+	}
+	queue, err := q.getQueue(ctx, queueName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	now := time.Now()
+	sentIDs := make([]string, 0, len(entries))
+	messages := make([]*domain.Message, 0, len(entries))
+	failed := make([]domain.BatchEntryError, 0)
+	for _, entry := range entries {
+		if err := validateOutgoingMessage(queue, entry.OutgoingMessage); err != nil {
+			failed = append(failed, domain.BatchEntryError{ID: entry.ID, Err: err})
+			continue
+		}
+
+		sentIDs = append(sentIDs, entry.ID)
+		messages = append(messages, newMessage(queue, entry.OutgoingMessage, now))
+	}
+
+	if err := q.offloadBodies(ctx, messages); err != nil {
+		return nil, nil, err
+	}
+	if err := q.messageRepos.SaveBatch(ctx, messages); err != nil {
+		q.releaseBlobs(ctx, messages, false)
+		return nil, nil, fmt.Errorf("save_message: error to save the messages: %w", err)
+	}
+	q.releaseBlobs(ctx, messages, true)
+	q.notifier.notify(queueName)
+
+	// Saving replaces the ID of deduplicated messages
+	successful := make([]domain.SendResult, 0, len(messages))
+	for i, message := range messages {
+		successful = append(successful, domain.SendResult{ID: sentIDs[i], MessageID: message.ID})
+	}
+
+	return successful, failed, nil
+}
 
-	for _, msg := range q.messages {
-		if time.Now().After(msg.VisibilityTimeout) {
-			msg.VisibilityTimeout = time.Now().Add(timeout)
-			return msg, nil
+// ReceiveMessage retrieves up to opts.MaxMessages messages from the queue, or
+// a single one when it is 0, and hides them for the queue's visibility
+// timeout. When the queue has no visible message it waits up to the wait time
+// for one, waking up on sends to the queue and when a hidden message becomes
+// visible again.
+func (q *queueService) ReceiveMessage(ctx context.Context, queueName string, opts domain.ReceiveOptions) ([]*domain.Message, error) {
+	if err := domain.ValidateMaxNumberOfMessages(opts.MaxMessages); err != nil {
+		return nil, err
+	}
+	if opts.WaitTime != nil {
+		if err := domain.ValidateWaitTime(*opts.WaitTime); err != nil {
+			return nil, err
 		}
 	}
+	if err := domain.ValidateAttributeNames(opts.AttributeNames, opts.MessageAttributeNames); err != nil {
+		return nil, err
+	}
+
+	queue, err := q.getQueue(ctx, queueName)
+	if err != nil {
+		return nil, err
+	}
+
+	waitTime := queue.ReceiveWaitTime
+	if opts.WaitTime != nil {
+		waitTime = *opts.WaitTime
+	}
+	deadline := time.Now().Add(waitTime)
+
+	for {
+		wake := q.notifier.wait(queueName)
+
+		messages, err := q.receive(ctx, queue, opts.MaxMessages)
+		if err != nil {
+			return nil, err
+		}
+		if len(messages) > 0 {
+			// Only the requested attributes are returned
+			for _, message := range messages {
+				message.Attributes = message.Attributes.Select(opts.MessageAttributeNames)
+			}
+			return messages, nil
+		}
+
+		now := time.Now()
+		if !now.Before(deadline) {
+			return nil, domain.ErrNoMessageAvailable
+		}
 
-	return nil, errors.New("no available message") // No available message
+		// Sleep until the wait ends, a message is sent or the first hidden
+		// message becomes visible, whichever comes first
+		wakeAt := deadline
+		next, err := q.messageRepos.NextVisibleAt(ctx, queueName, now)
+		if err != nil {
+			return nil, err
+		}
+		if !next.IsZero() && next.Before(wakeAt) {
+			wakeAt = next
+		}
+
+		timer := time.NewTimer(wakeAt.Sub(now))
+		select {
+		case <-wake:
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		}
+		timer.Stop()
+
+		// The attributes of the queue may have changed while waiting
+		if queue, err = q.getQueue(ctx, queueName); err != nil {
+			return nil, err
+		}
+	}
 }
 
-// DeleteMessage deletes a message using its receipt handle
+// receive claims up to maxMessages visible messages of queue without waiting
+func (q *queueService) receive(ctx context.Context, queue *domain.Queue, maxMessages int) ([]*domain.Message, error) {
+	if maxMessages == 0 {
+		maxMessages = 1
+	}
+
+	deadLetterQueue, err := q.deadLetterQueue(ctx, queue)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := make([]string, 0, maxMessages)
+	for i := 0; i < maxMessages; i++ {
+		tokens = append(tokens, generateDeliveryToken())
+	}
+
+	// The claim hides each message and records its new delivery token in a
+	// single statement, so concurrent receivers never get the same delivery
+	now := time.Now()
+	messages, err := q.messageRepos.Claim(ctx, domain.ClaimRequest{
+		Queue:           queue,
+		DeadLetterQueue: deadLetterQueue,
+		ReceiptHandles:  tokens,
+		Now:             now,
+		VisibleAt:       now.Add(queue.VisibilityTimeout),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if deadLetterQueue != nil {
+		// The claim may have dead-lettered messages
+		q.notifier.notify(deadLetterQueue.Name)
+	}
+
+	for _, message := range messages {
+		message.ReceiptHandle = domain.NewReceiptHandle(message.ID, message.ReceiptHandle)
+	}
+	if err := q.loadBodies(ctx, messages); err != nil {
+		return nil, err
+	}
+
+	return messages, nil
+}
+
+// DeleteMessage deletes a message of the named queue using its receipt handle
 func (q *queueService) DeleteMessage(ctx context.Context, queueName string, receiptHandle string) (bool, error) {
-	q.mu.Lock()
-	defer q.mu.Unlock()
+	if _, err := q.getQueue(ctx, queueName); err != nil {
+		return false, err
+	}
+	messageID, token, err := domain.ParseReceiptHandle(receiptHandle)
+	if err != nil {
+		return false, err
+	}
+
+	deleted, err := q.messageRepos.DeleteByReceiptHandle(ctx, queueName, token)
+	if err != nil {
+		return false, err
+	}
+	if deleted {
+		// Offloaded bodies are stored under the ID of their message
+		q.deleteBlobs(ctx, []string{messageID})
+		return true, nil
+	}
+
+	return false, q.receiptHandleError(ctx, queueName, messageID, token)
+}
+
+// DeleteMessageBatch deletes up to domain.MaxBatchEntries messages of the named
+// queue with a single write. It returns the ids of the entries that succeeded
+// and the errors of those that failed.
+func (q *queueService) DeleteMessageBatch(ctx context.Context, queueName string, entries []domain.DeleteEntry) ([]string, []domain.BatchEntryError, error) {
+	ids := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		ids = append(ids, entry.ID)
+	}
+	if err := domain.ValidateBatchEntryIDs(ids); err != nil {
+		return nil, nil, err
+	}
+	if _, err := q.getQueue(ctx, queueName); err != nil {
+		return nil, nil, err
+	}
+
+	// Entries with a malformed receipt handle fail without reaching the repository
+	messageIDs := make([]string, len(entries))
+	tokens := make([]string, len(entries))
+	valid := make([]string, 0, len(entries))
+	for i, entry := range entries {
+		messageIDs[i], tokens[i], _ = domain.ParseReceiptHandle(entry.ReceiptHandle)
+		if tokens[i] != "" {
+			valid = append(valid, tokens[i])
+		}
+	}
+
+	deletedTokens, err := q.messageRepos.DeleteByReceiptHandles(ctx, queueName, valid)
+	if err != nil {
+		return nil, nil, err
+	}
+	deleted := make(map[string]bool, len(deletedTokens))
+	for _, token := range deletedTokens {
+		deleted[token] = true
+	}
 
-	for j, msg := range q.messages {
-		if msg.ReceiptHandle == receiptHandle {
-			q.messages = append(q.messages[:j], q.messages[j+1:]...)
-			return true, nil
+	successful := make([]string, 0, len(entries))
+	failed := make([]domain.BatchEntryError, 0)
+	deletedIDs := make([]string, 0, len(deletedTokens))
+	for i, entry := range entries {
+		switch {
+		case tokens[i] == "":
+			failed = append(failed, domain.BatchEntryError{ID: entry.ID, Err: domain.ErrReceiptHandleNotFound})
+		case deleted[tokens[i]]:
+			successful = append(successful, entry.ID)
+			deletedIDs = append(deletedIDs, messageIDs[i])
+		default:
+			failed = append(failed, domain.BatchEntryError{
+				ID:  entry.ID,
+				Err: q.receiptHandleError(ctx, queueName, messageIDs[i], tokens[i]),
+			})
 		}
 	}
+	q.deleteBlobs(ctx, deletedIDs)
+
+	return successful, failed, nil
+}
+
+// ChangeMessageVisibility hides an in-flight message of the named queue for
+// timeout from now. A zero timeout makes the message visible again at once.
+func (q *queueService) ChangeMessageVisibility(ctx context.Context, queueName string, receiptHandle string, timeout time.Duration) error {
+	if err := domain.ValidateVisibilityTimeout(timeout); err != nil {
+		return err
+	}
+	if _, err := q.getQueue(ctx, queueName); err != nil {
+		return err
+	}
+
+	return q.changeMessageVisibility(ctx, queueName, receiptHandle, timeout)
+}
+
+// ChangeMessageVisibilityBatch changes the visibility of up to
+// domain.MaxBatchEntries in-flight messages of the named queue. It returns the
+// ids of the entries that succeeded and the errors of those that failed.
+func (q *queueService) ChangeMessageVisibilityBatch(ctx context.Context, queueName string, entries []domain.VisibilityChange) ([]string, []domain.BatchEntryError, error) {
+	ids := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		ids = append(ids, entry.ID)
+	}
+	if err := domain.ValidateBatchEntryIDs(ids); err != nil {
+		return nil, nil, err
+	}
+	if _, err := q.getQueue(ctx, queueName); err != nil {
+		return nil, nil, err
+	}
+
+	successful := make([]string, 0, len(entries))
+	failed := make([]domain.BatchEntryError, 0)
+	for _, entry := range entries {
+		err := domain.ValidateVisibilityTimeout(entry.VisibilityTimeout)
+		if err == nil {
+			err = q.changeMessageVisibility(ctx, queueName, entry.ReceiptHandle, entry.VisibilityTimeout)
+		}
+		if err != nil {
+			failed = append(failed, domain.BatchEntryError{ID: entry.ID, Err: err})
+			continue
+		}
+		successful = append(successful, entry.ID)
+	}
+
+	return successful, failed, nil
+}
+
+// changeMessageVisibility moves the visibility deadline of the in-flight
+// message holding receiptHandle and explains why when no message was changed
+func (q *queueService) changeMessageVisibility(ctx context.Context, queueName string, receiptHandle string, timeout time.Duration) error {
+	messageID, token, err := domain.ParseReceiptHandle(receiptHandle)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	changed, err := q.messageRepos.ChangeVisibility(ctx, queueName, token, now, now.Add(timeout))
+	if err != nil {
+		return err
+	}
+	if changed {
+		// Waiting receives recompute when the next message becomes visible
+		q.notifier.notify(queueName)
+		return nil
+	}
 
-	return false, errors.New("was not possible to delete the message.")
+	return q.receiptHandleError(ctx, queueName, messageID, token)
+}
+
+// receiptHandleError explains why the receipt handle made of messageID and
+// token did not match an in-flight message of the named queue
+func (q *queueService) receiptHandleError(ctx context.Context, queueName string, messageID string, token string) error {
+	msg, err := q.messageRepos.GetByReceiptHandle(ctx, token)
+	if err != nil {
+		return err
+	}
+	if msg == nil {
+		// The message is gone, or a later receive replaced the token
+		msg, err = q.messageRepos.GetByMessageID(ctx, messageID)
+		if err != nil {
+			return err
+		}
+		if msg == nil {
+			return domain.ErrReceiptHandleNotFound
+		}
+		if msg.QueueName == queueName {
+			return domain.ErrReceiptHandleStale
+		}
+	}
+	if msg.QueueName != queueName {
+		return fmt.Errorf("%w: the message is stored in queue %q, not %q",
+			domain.ErrReceiptHandleMismatch, msg.QueueName, queueName)
+	}
+	return domain.ErrReceiptHandleExpired
+}
+
+// validateMaximumMessageSize keeps bodies larger than
+// domain.MaxInlineMessageSize out of the message repository: queues only
+// accept them when they are offloaded to a blob store
+func (q *queueService) validateMaximumMessageSize(attrs domain.QueueAttributes) error {
+	if q.blobs == nil && attrs.MaximumMessageSize != nil && *attrs.MaximumMessageSize > domain.MaxInlineMessageSize {
+		return fmt.Errorf("%w: MaximumMessageSize above %d needs a blob store",
+			domain.ErrInvalidQueueAttribute, domain.MaxInlineMessageSize)
+	}
+	return nil
+}
+
+// validateRedrivePolicy checks that the dead-letter queue named by the redrive
+// policy of queue exists
+func (q *queueService) validateRedrivePolicy(ctx context.Context, queue *domain.Queue) error {
+	if queue.RedrivePolicy == nil {
+		return nil
+	}
+
+	deadLetterQueue, err := q.queueRepo.GetByName(ctx, queue.RedrivePolicy.DeadLetterQueueName)
+	if err != nil {
+		return err
+	}
+	if deadLetterQueue == nil {
+		return fmt.Errorf("%w: dead-letter queue %q does not exist",
+			domain.ErrInvalidQueueAttribute, queue.RedrivePolicy.DeadLetterQueueName)
+	}
+	if deadLetterQueue.FifoQueue != queue.FifoQueue {
+		return fmt.Errorf("%w: dead-letter queue %q must be a FIFO queue exactly when the queue is one",
+			domain.ErrInvalidQueueAttribute, queue.RedrivePolicy.DeadLetterQueueName)
+	}
+	return nil
+}
+
+// deadLetterQueue loads the dead-letter queue of queue. It returns nil when
+// the queue has no redrive policy or its dead-letter queue was deleted, in
+// which case messages are redelivered instead of dead-lettered.
+func (q *queueService) deadLetterQueue(ctx context.Context, queue *domain.Queue) (*domain.Queue, error) {
+	if queue.RedrivePolicy == nil {
+		return nil, nil
+	}
+	return q.queueRepo.GetByName(ctx, queue.RedrivePolicy.DeadLetterQueueName)
+}
+
+// getQueue loads a queue by name, returning domain.ErrQueueNotFound when it does not exist
+func (q *queueService) getQueue(ctx context.Context, queueName string) (*domain.Queue, error) {
+	queue, err := q.queueRepo.GetByName(ctx, queueName)
+	if err != nil {
+		return nil, err
+	}
+	if queue == nil {
+		return nil, domain.ErrQueueNotFound
+	}
+	return queue, nil
+}
+
+// validateOutgoingMessage checks a message sent to queue. FIFO queues need a
+// message group id, which standard queues do not support.
+func validateOutgoingMessage(queue *domain.Queue, outgoing domain.OutgoingMessage) error {
+	if err := domain.ValidateMessageAttributes(outgoing.Attributes); err != nil {
+		return err
+	}
+	if err := domain.ValidateContentType(outgoing.ContentType, outgoing.ContentEncoding); err != nil {
+		return err
+	}
+	// Attributes count towards the maximum message size
+	if size := len(outgoing.Body) + outgoing.Attributes.Size(); size > queue.MaximumMessageSize {
+		return fmt.Errorf("%w: %d bytes, the queue allows %d", domain.ErrMessageTooLarge, size, queue.MaximumMessageSize)
+	}
+	if outgoing.Delay != nil {
+		if err := domain.ValidateDelay(*outgoing.Delay); err != nil {
+			return err
+		}
+	}
+	if outgoing.DeduplicationID != "" {
+		if err := domain.ValidateDeduplicationID(outgoing.DeduplicationID); err != nil {
+			return err
+		}
+	}
+	if outgoing.Priority != nil {
+		if !queue.PriorityQueue {
+			return fmt.Errorf("%w: only priority queues support message priorities", domain.ErrInvalidPriority)
+		}
+		if err := domain.ValidatePriority(*outgoing.Priority); err != nil {
+			return err
+		}
+	}
+	if queue.FifoQueue {
+		return domain.ValidateMessageGroupID(outgoing.MessageGroupID)
+	}
+	if outgoing.MessageGroupID != "" {
+		return fmt.Errorf("%w: only FIFO queues support message groups", domain.ErrInvalidMessageGroupID)
+	}
+	return nil
+}
+
+// newMessage builds a message of queue sent at now, hidden until its delivery
+// delay, or else the queue's, elapses
+func newMessage(queue *domain.Queue, outgoing domain.OutgoingMessage, now time.Time) *domain.Message {
+	delay := queue.Delay
+	if outgoing.Delay != nil {
+		delay = *outgoing.Delay
+	}
+	priority := domain.MinPriority
+	if outgoing.Priority != nil {
+		priority = *outgoing.Priority
+	}
+
+	return &domain.Message{
+		ID:                generateID(),
+		Body:              outgoing.Body,
+		ContentType:       outgoing.ContentType,
+		ContentEncoding:   outgoing.ContentEncoding,
+		Compression:       queue.Compression,
+		QueueName:         queue.Name,
+		VisibilityTimeout: now.Add(delay),
+		SentAt:            now,
+		MessageGroupID:    outgoing.MessageGroupID,
+		Priority:          priority,
+		Attributes:        outgoing.Attributes,
+		Deduplication:     deduplication(queue, outgoing, now),
+	}
+}
+
+// deduplication reserves the deduplication id of a message sent at now for
+// the deduplication window of queue. Queues with content-based deduplication
+// use the SHA-256 hash of the body when the producer sets no id. It returns
+// nil when the message is not deduplicated.
+func deduplication(queue *domain.Queue, outgoing domain.OutgoingMessage, now time.Time) *domain.Deduplication {
+	id := outgoing.DeduplicationID
+	if id == "" && queue.ContentBasedDeduplication {
+		sum := sha256.Sum256(outgoing.Body)
+		id = hex.EncodeToString(sum[:])
+	}
+	if id == "" {
+		return nil
+	}
+	return &domain.Deduplication{ID: id, ExpiresAt: now.Add(queue.DeduplicationWindow)}
 }
 
-// Utility functions to generate IDs and receipt handles
+// Utility functions to generate IDs and delivery tokens
 func generateID() string {
 	return uuid.New().String()
 }
 
-func generateReceiptHandle() string {
+func generateDeliveryToken() string {
 	return uuid.New().String()
 }
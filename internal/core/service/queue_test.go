@@ -0,0 +1,296 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"queueserver/internal/adapter/repository"
+	"queueserver/internal/core/domain"
+	"queueserver/internal/core/port/service"
+	queueService "queueserver/internal/core/service"
+)
+
+// newTestService returns a queue service backed by the memory repositories
+func newTestService(t *testing.T) service.QueueService {
+	t.Helper()
+	queueRepo := repository.NewMemoryQueueRepository()
+	messageRepo := repository.NewMemoryMessageRepository(queueRepo)
+	svc := queueService.NewQueueService(queueRepo, messageRepo, repository.NewMemoryScheduleRepository(messageRepo))
+	t.Cleanup(func() { svc.Close() })
+	return svc
+}
+
+func createQueue(t *testing.T, svc service.QueueService, name string, attrs domain.QueueAttributes) {
+	t.Helper()
+	if _, err := svc.CreateQueue(context.Background(), name, attrs); err != nil {
+		t.Fatalf("CreateQueue(%s) error = %v", name, err)
+	}
+}
+
+// receive returns the messages visible in the named queue without waiting
+func receive(t *testing.T, svc service.QueueService, queueName string, maxMessages int) []*domain.Message {
+	t.Helper()
+	noWait := time.Duration(0)
+	messages, err := svc.ReceiveMessage(context.Background(), queueName, domain.ReceiveOptions{MaxMessages: maxMessages, WaitTime: &noWait})
+	if errors.Is(err, domain.ErrNoMessageAvailable) {
+		return nil
+	}
+	if err != nil {
+		t.Fatalf("ReceiveMessage(%s) error = %v", queueName, err)
+	}
+	return messages
+}
+
+func bodies(messages []*domain.Message) []string {
+	result := make([]string, 0, len(messages))
+	for _, message := range messages {
+		result = append(result, string(message.Body))
+	}
+	return result
+}
+
+func TestDeadLetterQueue(t *testing.T) {
+	tests := []struct {
+		name            string
+		maxReceiveCount int
+	}{
+		{name: "dead-lettered after one receive", maxReceiveCount: 1},
+		{name: "dead-lettered after three receives", maxReceiveCount: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			svc := newTestService(t)
+			noVisibility := time.Duration(0)
+			createQueue(t, svc, "dlq", domain.QueueAttributes{})
+			createQueue(t, svc, "source", domain.QueueAttributes{
+				VisibilityTimeout: &noVisibility,
+				RedrivePolicy:     &domain.RedrivePolicy{DeadLetterQueueName: "dlq", MaxReceiveCount: tt.maxReceiveCount},
+			})
+			id, err := svc.SendMessage(ctx, "source", domain.OutgoingMessage{Body: []byte("poison")})
+			if err != nil {
+				t.Fatalf("SendMessage() error = %v", err)
+			}
+
+			for i := 1; i <= tt.maxReceiveCount; i++ {
+				messages := receive(t, svc, "source", 1)
+				if len(messages) != 1 || messages[0].ReceiveCount != i {
+					t.Fatalf("receive %d got %d messages, want the message received %d times", i, len(messages), i)
+				}
+			}
+			if messages := receive(t, svc, "source", 1); len(messages) != 0 {
+				t.Fatalf("the message was received %d times, more than the redrive policy allows", tt.maxReceiveCount+1)
+			}
+
+			messages := receive(t, svc, "dlq", 1)
+			if len(messages) != 1 {
+				t.Fatalf("the dead-letter queue holds %d messages, want 1", len(messages))
+			}
+			message := messages[0]
+			if message.ID != id || message.SourceQueueName != "source" || message.QueueName != "dlq" {
+				t.Errorf("dead-lettered message = %s from %q in %q, want %s from %q in %q",
+					message.ID, message.SourceQueueName, message.QueueName, id, "source", "dlq")
+			}
+			// The counters carry over to the dead-letter queue
+			if message.ReceiveCount != tt.maxReceiveCount+1 {
+				t.Errorf("ReceiveCount = %d, want %d", message.ReceiveCount, tt.maxReceiveCount+1)
+			}
+		})
+	}
+}
+
+func TestFifoOrdering(t *testing.T) {
+	tests := []struct {
+		name  string
+		sends []domain.OutgoingMessage
+		// Each receive deletes what it got before the next one
+		wantBatches [][]string
+	}{
+		{
+			name: "one group",
+			sends: []domain.OutgoingMessage{
+				{Body: []byte("a1"), MessageGroupID: "a"},
+				{Body: []byte("a2"), MessageGroupID: "a"},
+				{Body: []byte("a3"), MessageGroupID: "a"},
+			},
+			wantBatches: [][]string{{"a1"}, {"a2"}, {"a3"}},
+		},
+		{
+			name: "interleaved groups",
+			sends: []domain.OutgoingMessage{
+				{Body: []byte("a1"), MessageGroupID: "a"},
+				{Body: []byte("b1"), MessageGroupID: "b"},
+				{Body: []byte("a2"), MessageGroupID: "a"},
+				{Body: []byte("a3"), MessageGroupID: "a"},
+				{Body: []byte("b2"), MessageGroupID: "b"},
+			},
+			wantBatches: [][]string{{"a1", "b1"}, {"a2", "b2"}, {"a3"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			svc := newTestService(t)
+			fifo := true
+			createQueue(t, svc, "fifo", domain.QueueAttributes{FifoQueue: &fifo})
+			for _, outgoing := range tt.sends {
+				if _, err := svc.SendMessage(ctx, "fifo", outgoing); err != nil {
+					t.Fatalf("SendMessage() error = %v", err)
+				}
+			}
+
+			for i, want := range tt.wantBatches {
+				messages := receive(t, svc, "fifo", domain.MaxBatchEntries)
+				if got := bodies(messages); fmt.Sprint(got) != fmt.Sprint(want) {
+					t.Fatalf("receive %d = %v, want %v", i+1, got, want)
+				}
+				// A group stays blocked while its head is in flight
+				if again := receive(t, svc, "fifo", domain.MaxBatchEntries); len(again) != 0 {
+					t.Fatalf("receive %d handed out %v while the group heads were in flight", i+1, bodies(again))
+				}
+				for _, message := range messages {
+					if _, err := svc.DeleteMessage(ctx, "fifo", message.ReceiptHandle); err != nil {
+						t.Fatalf("DeleteMessage() error = %v", err)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestDeduplication(t *testing.T) {
+	window := time.Second
+	enabled := true
+
+	tests := []struct {
+		name        string
+		attrs       domain.QueueAttributes
+		sends       []domain.OutgoingMessage
+		pause       time.Duration // before the last send
+		wantBodies  []string
+		wantSameIDs bool // every send returns the ID of the first message
+	}{
+		{
+			name: "repeated deduplication id",
+			sends: []domain.OutgoingMessage{
+				{Body: []byte("first"), DeduplicationID: "order-1"},
+				{Body: []byte("second"), DeduplicationID: "order-1"},
+			},
+			wantBodies:  []string{"first"},
+			wantSameIDs: true,
+		},
+		{
+			name: "distinct deduplication ids",
+			sends: []domain.OutgoingMessage{
+				{Body: []byte("first"), DeduplicationID: "order-1"},
+				{Body: []byte("second"), DeduplicationID: "order-2"},
+			},
+			wantBodies: []string{"first", "second"},
+		},
+		{
+			name:  "content-based deduplication",
+			attrs: domain.QueueAttributes{ContentBasedDeduplication: &enabled},
+			sends: []domain.OutgoingMessage{
+				{Body: []byte("same")},
+				{Body: []byte("same")},
+			},
+			wantBodies:  []string{"same"},
+			wantSameIDs: true,
+		},
+		{
+			name:  "repeat after the window",
+			attrs: domain.QueueAttributes{DeduplicationWindow: &window},
+			sends: []domain.OutgoingMessage{
+				{Body: []byte("first"), DeduplicationID: "order-1"},
+				{Body: []byte("second"), DeduplicationID: "order-1"},
+			},
+			pause:      window + 100*time.Millisecond,
+			wantBodies: []string{"first", "second"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			svc := newTestService(t)
+			createQueue(t, svc, "queue", tt.attrs)
+
+			ids := make([]string, 0, len(tt.sends))
+			for i, outgoing := range tt.sends {
+				if i == len(tt.sends)-1 {
+					time.Sleep(tt.pause)
+				}
+				id, err := svc.SendMessage(ctx, "queue", outgoing)
+				if err != nil {
+					t.Fatalf("SendMessage() error = %v", err)
+				}
+				ids = append(ids, id)
+			}
+
+			if got := bodies(receive(t, svc, "queue", domain.MaxBatchEntries)); fmt.Sprint(got) != fmt.Sprint(tt.wantBodies) {
+				t.Errorf("received %v, want %v", got, tt.wantBodies)
+			}
+			for _, id := range ids[1:] {
+				if same := id == ids[0]; same != tt.wantSameIDs {
+					t.Errorf("send returned ID %s after %s, want the same ID: %v", id, ids[0], tt.wantSameIDs)
+				}
+			}
+		})
+	}
+}
+
+func TestStaleReceiptHandles(t *testing.T) {
+	ctx := context.Background()
+	svc := newTestService(t)
+	createQueue(t, svc, "queue", domain.QueueAttributes{})
+	createQueue(t, svc, "other", domain.QueueAttributes{})
+	if _, err := svc.SendMessage(ctx, "queue", domain.OutgoingMessage{Body: []byte("body")}); err != nil {
+		t.Fatalf("SendMessage() error = %v", err)
+	}
+
+	// Receive the message twice, making it visible again in between
+	first := receive(t, svc, "queue", 1)
+	if len(first) != 1 {
+		t.Fatalf("got %d messages, want 1", len(first))
+	}
+	if err := svc.ChangeMessageVisibility(ctx, "queue", first[0].ReceiptHandle, 0); err != nil {
+		t.Fatalf("ChangeMessageVisibility() error = %v", err)
+	}
+	second := receive(t, svc, "queue", 1)
+	if len(second) != 1 {
+		t.Fatalf("got %d messages, want 1", len(second))
+	}
+	stale, current := first[0].ReceiptHandle, second[0].ReceiptHandle
+
+	tests := []struct {
+		name          string
+		queueName     string
+		receiptHandle string
+		wantErr       error
+	}{
+		{name: "stale handle", queueName: "queue", receiptHandle: stale, wantErr: domain.ErrReceiptHandleStale},
+		{name: "other queue", queueName: "other", receiptHandle: current, wantErr: domain.ErrReceiptHandleMismatch},
+		{name: "malformed handle", queueName: "queue", receiptHandle: "not a handle", wantErr: domain.ErrReceiptHandleNotFound},
+		{name: "unknown message", queueName: "queue", receiptHandle: domain.NewReceiptHandle("missing", "token"), wantErr: domain.ErrReceiptHandleNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := svc.ChangeMessageVisibility(ctx, tt.queueName, tt.receiptHandle, time.Minute); !errors.Is(err, tt.wantErr) {
+				t.Errorf("ChangeMessageVisibility() error = %v, want %v", err, tt.wantErr)
+			}
+			if deleted, err := svc.DeleteMessage(ctx, tt.queueName, tt.receiptHandle); deleted || !errors.Is(err, tt.wantErr) {
+				t.Errorf("DeleteMessage() = %v, %v, want %v", deleted, err, tt.wantErr)
+			}
+		})
+	}
+
+	// The current handle still works, and only once
+	if deleted, err := svc.DeleteMessage(ctx, "queue", current); !deleted || err != nil {
+		t.Fatalf("DeleteMessage() = %v, %v, want the message deleted", deleted, err)
+	}
+	if _, err := svc.DeleteMessage(ctx, "queue", current); !errors.Is(err, domain.ErrReceiptHandleNotFound) {
+		t.Errorf("second DeleteMessage() error = %v, want ErrReceiptHandleNotFound", err)
+	}
+}
@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"queueserver/internal/core/domain"
+)
+
+// Settings of the background retention reaper
+const (
+	reaperInterval  = 10 * time.Second // how often expired messages are looked up
+	reaperBatchSize = 500              // expired messages deleted per statement
+)
+
+// reaper deletes the messages that outlived the retention period of their
+// queue, and the blobs no message references, in the background until closed
+type reaper struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// startReaper starts deleting the expired messages of q every reaperInterval
+func (q *queueService) startReaper() *reaper {
+	ctx, cancel := context.WithCancel(context.Background())
+	r := &reaper{cancel: cancel, done: make(chan struct{})}
+
+	go func() {
+		defer close(r.done)
+
+		ticker := time.NewTicker(reaperInterval)
+		defer ticker.Stop()
+
+		nextSweep := time.Now().Add(blobSweepInterval)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case now := <-ticker.C:
+				if q.blobs != nil && !now.Before(nextSweep) {
+					if err := q.sweepBlobs(ctx, now); err != nil && ctx.Err() == nil {
+						log.Printf("reaper: %v", err)
+					}
+					nextSweep = now.Add(blobSweepInterval)
+				}
+			}
+			if err := q.reapExpired(ctx); err != nil && ctx.Err() == nil {
+				log.Printf("reaper: %v", err)
+			}
+		}
+	}()
+	return r
+}
+
+// Close stops the reaper and waits for the run in progress
+func (r *reaper) Close() error {
+	r.cancel()
+	<-r.done
+	return nil
+}
+
+// reapExpired deletes the expired messages of every queue and the expired
+// deduplication ids
+func (q *queueService) reapExpired(ctx context.Context) error {
+	now := time.Now()
+	if err := q.messageRepos.PurgeDeduplication(ctx, now); err != nil {
+		return err
+	}
+
+	startAfter := ""
+	for {
+		queues, err := q.queueRepo.List(ctx, "", startAfter, maxListQueuesMaxResults)
+		if err != nil {
+			return err
+		}
+		for _, queue := range queues {
+			if err := q.reapQueue(ctx, queue, now); err != nil {
+				return err
+			}
+		}
+		if len(queues) < maxListQueuesMaxResults {
+			return nil
+		}
+		startAfter = queues[len(queues)-1].Name
+	}
+}
+
+// reapQueue deletes the messages of queue sent longer than its retention
+// period before now with their offloaded bodies, reporting a lifecycle event
+// for each
+func (q *queueService) reapQueue(ctx context.Context, queue *domain.Queue, now time.Time) error {
+	sentBefore := now.Add(-queue.MessageRetentionPeriod)
+	for {
+		expired, err := q.messageRepos.DeleteExpired(ctx, queue.Name, sentBefore, reaperBatchSize)
+		if err != nil {
+			return err
+		}
+		keys := make([]string, 0)
+		for _, message := range expired {
+			if message.BlobKey != "" {
+				keys = append(keys, message.BlobKey)
+			}
+			q.emit(domain.LifecycleEvent{
+				Type:      domain.EventMessageExpired,
+				QueueName: queue.Name,
+				MessageID: message.ID,
+				At:        now,
+			})
+		}
+		q.deleteBlobs(ctx, keys)
+		if len(expired) < reaperBatchSize {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"queueserver/internal/adapter/repository"
+	"queueserver/internal/core/domain"
+)
+
+func TestReapExpired(t *testing.T) {
+	ctx := context.Background()
+	var mu sync.Mutex
+	events := make([]domain.LifecycleEvent, 0)
+	queueRepo := repository.NewMemoryQueueRepository()
+	messageRepo := repository.NewMemoryMessageRepository(queueRepo)
+	svc := NewQueueService(queueRepo, messageRepo, repository.NewMemoryScheduleRepository(messageRepo),
+		WithLifecycleListener(func(event domain.LifecycleEvent) {
+			mu.Lock()
+			defer mu.Unlock()
+			events = append(events, event)
+		}))
+	t.Cleanup(func() { svc.Close() })
+
+	retention := domain.MinMessageRetentionPeriod
+	if _, err := svc.CreateQueue(ctx, "short", domain.QueueAttributes{MessageRetentionPeriod: &retention}); err != nil {
+		t.Fatalf("CreateQueue() error = %v", err)
+	}
+	if _, err := svc.CreateQueue(ctx, "long", domain.QueueAttributes{}); err != nil {
+		t.Fatalf("CreateQueue() error = %v", err)
+	}
+
+	// More expired messages than one delete statement removes
+	now := time.Now()
+	expired := make(map[string]bool)
+	save := func(id string, queueName string, sentAt time.Time) {
+		message := &domain.Message{ID: id, QueueName: queueName, Body: []byte(id), VisibilityTimeout: sentAt, SentAt: sentAt}
+		if err := messageRepo.Save(ctx, message); err != nil {
+			t.Fatalf("Save() error = %v", err)
+		}
+	}
+	for i := 0; i <= reaperBatchSize; i++ {
+		id := fmt.Sprintf("expired-%d", i)
+		save(id, "short", now.Add(-2*retention))
+		expired[id] = true
+	}
+	save("recent", "short", now)
+	save("retained", "long", now.Add(-2*retention))
+
+	if err := svc.(*queueService).reapExpired(ctx); err != nil {
+		t.Fatalf("reapExpired() error = %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(events) != len(expired) {
+		t.Fatalf("got %d lifecycle events, want %d", len(events), len(expired))
+	}
+	for _, event := range events {
+		if event.Type != domain.EventMessageExpired || event.QueueName != "short" || !expired[event.MessageID] {
+			t.Fatalf("unexpected lifecycle event %+v", event)
+		}
+		delete(expired, event.MessageID)
+	}
+
+	for queueName, want := range map[string]string{"short": "recent", "long": "retained"} {
+		_, stats, err := svc.GetQueueAttributes(ctx, queueName)
+		if err != nil {
+			t.Fatalf("GetQueueAttributes() error = %v", err)
+		}
+		message, err := messageRepo.GetByMessageID(ctx, want)
+		if err != nil || message == nil || stats.Visible != 1 {
+			t.Errorf("%s holds %d messages, want only %s", queueName, stats.Visible, want)
+		}
+	}
+}
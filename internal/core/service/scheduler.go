@@ -0,0 +1,186 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"queueserver/internal/core/domain"
+)
+
+// Settings of the background scheduler
+const (
+	schedulerInterval  = time.Second // how often due schedules are looked up
+	schedulerBatchSize = 100         // due schedules loaded per lookup
+)
+
+// scheduler fires the due schedules in the background until closed
+type scheduler struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// startScheduler starts firing the due schedules of q every schedulerInterval
+func (q *queueService) startScheduler() *scheduler {
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &scheduler{cancel: cancel, done: make(chan struct{})}
+
+	go func() {
+		defer close(s.done)
+
+		ticker := time.NewTicker(schedulerInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			if err := q.fireDueSchedules(ctx); err != nil && ctx.Err() == nil {
+				log.Printf("scheduler: %v", err)
+			}
+		}
+	}()
+	return s
+}
+
+// Close stops the scheduler and waits for the fire in progress
+func (s *scheduler) Close() error {
+	s.cancel()
+	<-s.done
+	return nil
+}
+
+// ScheduleMessage schedules outgoing for queueName, once at deliverAt or on
+// every fire of cronExpression. Scheduled messages are enqueued without delay
+// and take no deduplication id, the schedule itself enqueues one message per fire.
+func (q *queueService) ScheduleMessage(ctx context.Context, queueName string, outgoing domain.OutgoingMessage, deliverAt time.Time, cronExpression string) (*domain.Schedule, error) {
+	queue, err := q.getQueue(ctx, queueName)
+	if err != nil {
+		return nil, err
+	}
+	if outgoing.Delay != nil || outgoing.DeduplicationID != "" || outgoing.Priority != nil || len(outgoing.Attributes) > 0 {
+		return nil, fmt.Errorf("%w: scheduled messages take no delay, deduplication id, priority or attributes", domain.ErrInvalidSchedule)
+	}
+	if err := validateOutgoingMessage(queue, outgoing); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	nextFireAt, err := domain.ValidateScheduleTiming(deliverAt, cronExpression, now)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule := &domain.Schedule{
+		ID:              generateID(),
+		QueueName:       queueName,
+		Body:            outgoing.Body,
+		ContentType:     outgoing.ContentType,
+		ContentEncoding: outgoing.ContentEncoding,
+		MessageGroupID:  outgoing.MessageGroupID,
+		CronExpression:  cronExpression,
+		NextFireAt:      nextFireAt,
+		CreatedAt:       now,
+	}
+	if err := q.scheduleRepo.Save(ctx, schedule); err != nil {
+		return nil, err
+	}
+	return schedule, nil
+}
+
+// ListSchedules returns the schedules of queueName, earliest next fire first
+func (q *queueService) ListSchedules(ctx context.Context, queueName string) ([]*domain.Schedule, error) {
+	if _, err := q.getQueue(ctx, queueName); err != nil {
+		return nil, err
+	}
+	return q.scheduleRepo.ListByQueue(ctx, queueName)
+}
+
+// CancelSchedule removes a schedule, messages it already enqueued are kept
+func (q *queueService) CancelSchedule(ctx context.Context, scheduleID string) error {
+	deleted, err := q.scheduleRepo.Delete(ctx, scheduleID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return domain.ErrScheduleNotFound
+	}
+	return nil
+}
+
+// fireDueSchedules fires every schedule that is due
+func (q *queueService) fireDueSchedules(ctx context.Context) error {
+	for {
+		due, err := q.scheduleRepo.Due(ctx, time.Now(), schedulerBatchSize)
+		if err != nil {
+			return err
+		}
+		for _, schedule := range due {
+			if err := q.fireSchedule(ctx, schedule); err != nil {
+				return err
+			}
+		}
+		if len(due) < schedulerBatchSize {
+			return nil
+		}
+	}
+}
+
+// fireSchedule enqueues the message of a due schedule and advances it. A
+// schedule whose queue was deleted, or no longer accepts its message, is
+// dropped.
+func (q *queueService) fireSchedule(ctx context.Context, schedule *domain.Schedule) error {
+	queue, err := q.queueRepo.GetByName(ctx, schedule.QueueName)
+	if err != nil {
+		return err
+	}
+	if queue == nil {
+		_, err := q.scheduleRepo.Delete(ctx, schedule.ID)
+		return err
+	}
+
+	noDelay := time.Duration(0)
+	outgoing := domain.OutgoingMessage{
+		Body:            schedule.Body,
+		ContentType:     schedule.ContentType,
+		ContentEncoding: schedule.ContentEncoding,
+		MessageGroupID:  schedule.MessageGroupID,
+		Delay:           &noDelay,
+	}
+	if err := validateOutgoingMessage(queue, outgoing); err != nil {
+		// The queue changed since the schedule was created, for instance its
+		// maximum message size was lowered, so the schedule can never fire
+		log.Printf("scheduler: cancelling schedule %s: %v", schedule.ID, err)
+		_, err := q.scheduleRepo.Delete(ctx, schedule.ID)
+		return err
+	}
+
+	now := time.Now()
+	message := newMessage(queue, outgoing, now)
+	// Content-based deduplication would drop the repeats of a recurring schedule
+	message.Deduplication = nil
+
+	hasNext, err := schedule.Advance(now)
+	if err != nil {
+		return err
+	}
+	messages := []*domain.Message{message}
+	if err := q.offloadBodies(ctx, messages); err != nil {
+		return err
+	}
+	fired, err := q.scheduleRepo.Fire(ctx, schedule, message, !hasNext)
+	if err != nil {
+		q.releaseBlobs(ctx, messages, false)
+		return err
+	}
+	if !fired {
+		// Another replica fired the schedule first
+		q.releaseBlobs(ctx, messages, false)
+		return nil
+	}
+	q.notifier.notify(queue.Name)
+	return nil
+}